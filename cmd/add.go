@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"fmt"
+
+	"fline-cli/internal/generator"
+	"fline-cli/internal/ui"
+	"fline-cli/internal/utils"
+
+	"github.com/spf13/cobra"
+)
+
+var addCmd = &cobra.Command{
+	Use:   "add <firebase|supabase|notifications>",
+	Short: "Add a backend integration to an existing project",
+	Long: `Add a backend integration to a project created with fline create.
+
+The command:
+  • Adds the missing packages to pubspec.yaml
+  • Generates the integration files (initializer, services)
+  • Registers the providers in lib/di/providers.dart
+  • Wires the initialization into main() in lib/main.dart
+
+Firebase gets the auth and firestore modules unless --modules selects
+others (auth, firestore, storage, messaging, analytics, crashlytics).
+It also writes lib/firebase_options.dart from android/app/google-services.json
+and ios/Runner/GoogleService-Info.plist, or from the files passed with
+--google-services and --google-service-info; without them the options are
+a stub until flutterfire configure is run.
+Supabase gets auth and database unless --modules selects others (auth,
+database, storage). On a project that already uses the backend, --modules
+adds modules to it. Supabase credentials go in env/dev.env and
+env/prod.env.
+
+Running it twice changes nothing. Existing files are kept unless
+--on-conflict overwrite is passed; the pubspec, the DI and main.dart are
+only ever extended.
+
+Notifications use the provider passed with --provider: fcm (Firebase Cloud
+Messaging, needs the Firebase integration), onesignal or local. Without it,
+projects with Firebase get FCM and the others local notifications.
+
+Example:
+  pine add firebase
+  pine add firebase --modules storage,analytics
+  pine add firebase --google-services ~/Downloads/google-services.json
+  pine add notifications
+  pine add notifications --provider onesignal
+  pine add supabase --on-conflict overwrite`,
+	ValidArgs:    generator.Integrations,
+	Args:         cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+	SilenceUsage: true,
+	RunE:         runAdd,
+}
+
+func init() {
+	rootCmd.AddCommand(addCmd)
+
+	addCmd.Flags().StringSlice("modules", nil, "Firebase or Supabase modules to add")
+	addCmd.Flags().String("google-services", "", "google-services.json to copy into the project (firebase only)")
+	addCmd.Flags().String("google-service-info", "", "GoogleService-Info.plist to copy into the project (firebase only)")
+	addCmd.Flags().String("provider", "", "Notification provider: fcm, onesignal or local (notifications only)")
+	addCmd.Flags().String("on-conflict", "skip", "What to do with existing files (overwrite, skip or fail)")
+}
+
+func runAdd(cmd *cobra.Command, args []string) error {
+	logger := ui.NewLogger("add")
+
+	writer := utils.NewFileWriter(".")
+	if !writer.PathExists("pubspec.yaml") {
+		logger.Error("Not in a Flutter project directory")
+		logger.Info("Please run this command from your Flutter project root")
+		return fmt.Errorf("pubspec.yaml not found")
+	}
+
+	value, _ := cmd.Flags().GetString("on-conflict")
+	policy, err := utils.ParseConflictPolicy(value)
+	if err != nil {
+		return err
+	}
+
+	gen := generator.NewIntegrationGenerator(".", policy)
+	if modules, _ := cmd.Flags().GetStringSlice("modules"); len(modules) > 0 {
+		if args[0] == generator.IntegrationNotifications {
+			return fmt.Errorf("--modules only applies to firebase and supabase")
+		}
+		gen.SetModules(modules)
+	}
+	android, _ := cmd.Flags().GetString("google-services")
+	ios, _ := cmd.Flags().GetString("google-service-info")
+	if android != "" || ios != "" {
+		if args[0] != generator.IntegrationFirebase {
+			return fmt.Errorf("--google-services and --google-service-info only apply to firebase")
+		}
+		gen.SetFirebaseConfigFiles(android, ios)
+	}
+	if provider, _ := cmd.Flags().GetString("provider"); provider != "" {
+		if args[0] != generator.IntegrationNotifications {
+			return fmt.Errorf("--provider only applies to notifications")
+		}
+		gen.SetNotificationProvider(provider)
+	}
+	if err := gen.Add(args[0]); err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	for _, path := range gen.Skipped() {
+		logger.Warning(fmt.Sprintf("Kept existing file: %s", path))
+	}
+
+	logger.NewLine()
+	logger.Info("Next step: flutter pub get")
+	return nil
+}
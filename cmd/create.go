@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 
 	"fline-cli/internal/config"
@@ -22,15 +23,22 @@ var createCmd = &cobra.Command{
 
 This interactive wizard will guide you through:
   • Project configuration
-  • Backend selection (Firebase/Supabase)
-  • Notification setup
+  • Backend selection (Firebase/Supabase/custom REST API)
+  • Firebase modules (auth, firestore, storage, messaging, analytics, crashlytics)
+  • Notifications (Firebase Cloud Messaging, OneSignal or local only)
   • Model generation from JSON
-  • Example screens generation`,
+  • Example screens generation, with Google, Apple and GitHub sign-in
+  • App shell: splash, onboarding carousel and bottom navigation
+
+Pass --manifest to read the whole configuration from a JSON file instead,
+e.g. {"projectName": "my_app", "useFirebase": true,
+      "firebase": {"enableAuth": true, "enableStorage": true}}`,
 	RunE: runCreate,
 }
 
 func init() {
 	rootCmd.AddCommand(createCmd)
+	requireToolchain(createCmd, utils.FlutterRequirement, utils.DartRequirement)
 
 	// Flags for non-interactive mode
 	createCmd.Flags().StringP("name", "n", "", "Project name")
@@ -39,7 +47,24 @@ func init() {
 	createCmd.Flags().BoolP("force", "f", false, "Force creation even if directory exists")
 	createCmd.Flags().Bool("firebase", false, "Enable Firebase integration")
 	createCmd.Flags().Bool("supabase", false, "Enable Supabase integration")
+	createCmd.Flags().StringSlice("firebase-modules", nil, "Firebase modules, implies --firebase (default: auth,firestore)")
+	createCmd.Flags().String("google-services", "", "google-services.json to generate Firebase options from (implies --firebase)")
+	createCmd.Flags().String("google-service-info", "", "GoogleService-Info.plist to generate Firebase options from (implies --firebase)")
+	createCmd.Flags().String("supabase-url", "", "Supabase project URL, written to env/*.env (implies --supabase)")
+	createCmd.Flags().String("supabase-anon-key", "", "Supabase anon key, written to env/*.env (implies --supabase)")
+	createCmd.Flags().StringSlice("supabase-modules", nil, "Supabase modules, implies --supabase (default: auth,database)")
+	createCmd.Flags().Bool("rest", false, "Authenticate against a custom REST API with JWT tokens")
+	createCmd.Flags().String("rest-base-url", "", "Default base URL of the REST API (implies --rest)")
+	createCmd.Flags().StringToString("rest-endpoints", nil, "REST auth endpoint paths, e.g. login=/v1/login,refresh=/v1/token (implies --rest)")
+	createCmd.Flags().String("notifications", "", "Notification provider: fcm (implies --firebase), onesignal or local")
+	createCmd.Flags().StringSlice("social", nil, "Social sign-in providers of the login screen: google, apple, github (needs an auth module)")
+	createCmd.Flags().String("manifest", "", "Read the project configuration from a JSON file (implies --no-interactive)")
 	createCmd.Flags().Bool("no-interactive", false, "Disable interactive mode")
+	createCmd.Flags().Bool("keep-on-failure", false, "Keep the partially generated project if creation fails (for debugging)")
+	createCmd.Flags().Bool("skip-pub-get", false, "Don't run flutter pub get (also skips code generation)")
+	createCmd.Flags().Bool("skip-codegen", false, "Don't run gen-l10n and build_runner")
+	createCmd.Flags().Bool("offline", false, "Run flutter pub get with --offline (pub cache only)")
+	createCmd.Flags().Duration("step-timeout", 0, "Timeout of each Flutter step, e.g. 20m (default: per-step defaults)")
 }
 
 func runCreate(cmd *cobra.Command, args []string) error {
@@ -47,11 +72,15 @@ func runCreate(cmd *cobra.Command, args []string) error {
 
 	// Check if non-interactive mode
 	noInteractive, _ := cmd.Flags().GetBool("no-interactive")
+	manifest, _ := cmd.Flags().GetString("manifest")
 
 	var cfg *config.ProjectConfig
 	var err error
 
-	if noInteractive {
+	if manifest != "" {
+		noInteractive = true
+		cfg, err = createFromManifest(cmd, manifest)
+	} else if noInteractive {
 		cfg, err = createFromFlags(cmd)
 	} else {
 		cfg, err = runInteractiveWizard()
@@ -62,6 +91,17 @@ func runCreate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	cfg.KeepOnFailure, _ = cmd.Flags().GetBool("keep-on-failure")
+	cfg.SkipPubGet, _ = cmd.Flags().GetBool("skip-pub-get")
+	cfg.SkipCodegen, _ = cmd.Flags().GetBool("skip-codegen")
+	cfg.Offline, _ = cmd.Flags().GetBool("offline")
+	// A manifest may set the step timeout too, the flag wins when given
+	if cmd.Flags().Changed("step-timeout") {
+		stepTimeout, _ := cmd.Flags().GetDuration("step-timeout")
+		cfg.StepTimeout = config.Duration(stepTimeout)
+	}
+	cfg.Normalize()
+
 	// Validate configuration
 	if err := validateConfig(cfg); err != nil {
 		logger.Error(fmt.Sprintf("Validation error: %s", err))
@@ -96,7 +136,7 @@ func runCreate(cmd *cobra.Command, args []string) error {
 	logger.Title("Creating your Flutter project...")
 
 	gen := generator.NewProjectGenerator(cfg)
-	if err := gen.Generate(); err != nil {
+	if err := gen.Generate(cmd.Context()); err != nil {
 		logger.Error(fmt.Sprintf("Failed to generate project: %s", err))
 		return err
 	}
@@ -155,6 +195,7 @@ func runInteractiveWizard() (*config.ProjectConfig, error) {
 					huh.NewOption("None", "none"),
 					huh.NewOption("Firebase", "firebase"),
 					huh.NewOption("Supabase", "supabase"),
+					huh.NewOption("Custom REST API (JWT)", "rest"),
 				).
 				Value(&backendChoice),
 		).Title("🔥 Backend Configuration"),
@@ -166,25 +207,135 @@ func runInteractiveWizard() (*config.ProjectConfig, error) {
 
 	cfg.UseFirebase = backendChoice == "firebase"
 	cfg.UseSupabase = backendChoice == "supabase"
+	cfg.UseRest = backendChoice == "rest"
 
-	// Notifications Setup
-	if cfg.UseFirebase {
-		notifForm := huh.NewForm(
+	// REST API base URL and auth endpoints
+	if cfg.UseRest {
+		restForm := huh.NewForm(
 			huh.NewGroup(
-				huh.NewConfirm().
-					Title("Enable Push Notifications?").
-					Description("Setup Firebase Cloud Messaging").
-					Value(&cfg.EnableNotifications),
-			).Title("🔔 Notifications"),
+				huh.NewInput().
+					Title("Base URL").
+					Description("e.g., https://api.example.com (leave empty to pass --dart-define=API_BASE_URL later)").
+					Value(&cfg.Rest.BaseURL),
+				huh.NewInput().
+					Title("Login endpoint").
+					Value(&cfg.Rest.LoginPath),
+				huh.NewInput().
+					Title("Register endpoint").
+					Value(&cfg.Rest.RegisterPath),
+				huh.NewInput().
+					Title("Refresh endpoint").
+					Description("Exchanges the refresh token for new tokens").
+					Value(&cfg.Rest.RefreshPath),
+				huh.NewInput().
+					Title("Logout endpoint").
+					Value(&cfg.Rest.LogoutPath),
+				huh.NewInput().
+					Title("Password reset endpoint").
+					Value(&cfg.Rest.PasswordResetPath),
+			).Title("🔐 REST API").
+				Description("The endpoints answer with {\"access_token\", \"refresh_token\"}"),
 		)
 
-		if err := notifForm.Run(); err != nil {
+		if err := restForm.Run(); err != nil {
 			return nil, err
 		}
 
-		if cfg.EnableNotifications {
-			cfg.NotificationService = "fcm"
+		cfg.Rest.BaseURL = strings.TrimSpace(cfg.Rest.BaseURL)
+	}
+
+	// Supabase project and modules
+	if cfg.UseSupabase {
+		modules := cfg.Supabase.Modules()
+		supabaseForm := huh.NewForm(
+			huh.NewGroup(
+				huh.NewInput().
+					Title("Project URL").
+					Description("e.g., https://abcd.supabase.co (leave empty to fill in env/*.env later)").
+					Value(&cfg.Supabase.ProjectURL).
+					Validate(utils.ValidateSupabaseURL),
+
+				huh.NewInput().
+					Title("Anon Key").
+					Description("Public anon key from Project Settings → API").
+					Value(&cfg.Supabase.AnonKey),
+
+				huh.NewMultiSelect[string]().
+					Title("Supabase Modules").
+					Description("Use SPACE to select/unselect, ENTER to confirm").
+					Options(
+						huh.NewOption("Authentication", config.SupabaseAuth),
+						huh.NewOption("Database (tables and realtime)", config.SupabaseDatabase),
+						huh.NewOption("Storage (file uploads)", config.SupabaseStorage),
+					).
+					Value(&modules),
+			).Title("💧 Supabase").
+				Description("Credentials are written to env/dev.env and env/prod.env, not to the source code"),
+		)
+
+		if err := supabaseForm.Run(); err != nil {
+			return nil, err
 		}
+
+		selected, _ := config.ParseSupabaseModules(modules)
+		selected.ProjectURL = strings.TrimSpace(cfg.Supabase.ProjectURL)
+		selected.AnonKey = strings.TrimSpace(cfg.Supabase.AnonKey)
+		cfg.Supabase = selected
+	}
+
+	// Firebase modules
+	if cfg.UseFirebase {
+		modules := cfg.Firebase.Modules()
+		modulesForm := huh.NewForm(
+			huh.NewGroup(
+				huh.NewMultiSelect[string]().
+					Title("Firebase Modules").
+					Description("Use SPACE to select/unselect, ENTER to confirm").
+					Options(
+						huh.NewOption("Authentication", config.FirebaseAuth),
+						huh.NewOption("Cloud Firestore", config.FirebaseFirestore),
+						huh.NewOption("Storage (file uploads)", config.FirebaseStorage),
+						huh.NewOption("Analytics", config.FirebaseAnalytics),
+						huh.NewOption("Crashlytics", config.FirebaseCrashlytics),
+					).
+					Value(&modules),
+			).Title("🔥 Firebase"),
+		)
+
+		if err := modulesForm.Run(); err != nil {
+			return nil, err
+		}
+
+		cfg.Firebase, _ = config.ParseFirebaseModules(modules)
+	}
+
+	// Notifications, independent of the backend except for FCM
+	notificationOptions := []huh.Option[string]{huh.NewOption("None", "none")}
+	if cfg.UseFirebase {
+		notificationOptions = append(notificationOptions, huh.NewOption("Firebase Cloud Messaging", config.NotificationsFCM))
+	}
+	notificationOptions = append(notificationOptions,
+		huh.NewOption("OneSignal", config.NotificationsOneSignal),
+		huh.NewOption("Local only (scheduled by the app)", config.NotificationsLocal),
+	)
+	notificationChoice := "none"
+	notificationsForm := huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title("Notifications").
+				Description("Permission request, foreground and tap handling, opening the tapped route").
+				Options(notificationOptions...).
+				Value(&notificationChoice),
+		).Title("🔔 Notifications"),
+	)
+
+	if err := notificationsForm.Run(); err != nil {
+		return nil, err
+	}
+
+	if notificationChoice != "none" {
+		cfg.EnableNotifications = true
+		cfg.NotificationService = notificationChoice
 	}
 
 	// Models from JSON
@@ -216,10 +367,14 @@ func runInteractiveWizard() (*config.ProjectConfig, error) {
 				Title("Example Screens").
 				Description("Use SPACE to select/unselect, ENTER to confirm").
 				Options(
-					huh.NewOption("Login Screen", "login"),
-					huh.NewOption("Home Screen", "home"),
-					huh.NewOption("Profile Screen", "profile"),
-					huh.NewOption("Settings Screen", "settings"),
+					huh.NewOption("Login Screen", "login").Selected(cfg.GenerateLoginScreen),
+					huh.NewOption("Home Screen", "home").Selected(cfg.GenerateHomeScreen),
+					huh.NewOption("Profile Screen", "profile").Selected(cfg.GenerateProfileScreen),
+					huh.NewOption("Settings Screen", "settings").Selected(cfg.GenerateSettingsScreen),
+					huh.NewOption("Splash Screen", "splash").Selected(cfg.GenerateSplashScreen),
+					huh.NewOption("Onboarding Carousel", "onboarding").Selected(cfg.GenerateOnboardingScreen),
+					huh.NewOption("Bottom Navigation Shell (Home, Profile, Settings tabs)", "shell").Selected(cfg.GenerateShellScreen),
+					huh.NewOption("Empty & Error State Widgets", "states").Selected(cfg.GenerateStateViews),
 				).
 				Value(&selectedScreens),
 		).Title("🎨 Screens"),
@@ -229,6 +384,29 @@ func runInteractiveWizard() (*config.ProjectConfig, error) {
 		return nil, err
 	}
 
+	// Social sign-in, on the login screen of the Firebase or Supabase auth
+	// module like ValidateSocialSignIn requires
+	backend := cfg.AuthBackend()
+	if slices.Contains(selectedScreens, "login") && (backend == config.AuthFirebase || backend == config.AuthSupabase) {
+		socialForm := huh.NewForm(
+			huh.NewGroup(
+				huh.NewMultiSelect[string]().
+					Title("Social Sign-In").
+					Description("Buttons on the login screen, with a checklist of the platform setup in SOCIAL_SIGN_IN.md").
+					Options(
+						huh.NewOption("Google", config.SocialGoogle),
+						huh.NewOption("Apple", config.SocialApple),
+						huh.NewOption("GitHub", config.SocialGitHub),
+					).
+					Value(&cfg.SocialSignIn),
+			).Title("🔑 Social Sign-In"),
+		)
+
+		if err := socialForm.Run(); err != nil {
+			return nil, err
+		}
+	}
+
 	// App context (optional)
 	var addContext bool
 	contextConfirmForm := huh.NewForm(
@@ -260,19 +438,15 @@ func runInteractiveWizard() (*config.ProjectConfig, error) {
 		}
 	}
 
-	// Set screen flags
-	for _, screen := range selectedScreens {
-		switch screen {
-		case "login":
-			cfg.GenerateLoginScreen = true
-		case "home":
-			cfg.GenerateHomeScreen = true
-		case "profile":
-			cfg.GenerateProfileScreen = true
-		case "settings":
-			cfg.GenerateSettingsScreen = true
-		}
-	}
+	// Set screen flags, the defaults included: deselected screens are off
+	cfg.GenerateLoginScreen = slices.Contains(selectedScreens, "login")
+	cfg.GenerateHomeScreen = slices.Contains(selectedScreens, "home")
+	cfg.GenerateProfileScreen = slices.Contains(selectedScreens, "profile")
+	cfg.GenerateSettingsScreen = slices.Contains(selectedScreens, "settings")
+	cfg.GenerateSplashScreen = slices.Contains(selectedScreens, "splash")
+	cfg.GenerateOnboardingScreen = slices.Contains(selectedScreens, "onboarding")
+	cfg.GenerateShellScreen = slices.Contains(selectedScreens, "shell")
+	cfg.GenerateStateViews = slices.Contains(selectedScreens, "states")
 
 	return cfg, nil
 }
@@ -354,6 +528,81 @@ func createFromFlags(cmd *cobra.Command) (*config.ProjectConfig, error) {
 	cfg.UseFirebase = firebase
 	cfg.UseSupabase = supabase
 
+	if cmd.Flags().Changed("firebase-modules") {
+		modules, _ := cmd.Flags().GetStringSlice("firebase-modules")
+		firebaseConfig, err := config.ParseFirebaseModules(modules)
+		if err != nil {
+			return nil, err
+		}
+		cfg.UseFirebase = true
+		cfg.Firebase = firebaseConfig
+	}
+
+	cfg.Firebase.AndroidConfig, _ = cmd.Flags().GetString("google-services")
+	cfg.Firebase.IOSConfig, _ = cmd.Flags().GetString("google-service-info")
+	if cfg.Firebase.AndroidConfig != "" || cfg.Firebase.IOSConfig != "" {
+		cfg.UseFirebase = true
+	}
+
+	if cmd.Flags().Changed("supabase-modules") {
+		modules, _ := cmd.Flags().GetStringSlice("supabase-modules")
+		supabaseConfig, err := config.ParseSupabaseModules(modules)
+		if err != nil {
+			return nil, err
+		}
+		cfg.UseSupabase = true
+		cfg.Supabase = supabaseConfig
+	}
+
+	cfg.Supabase.ProjectURL, _ = cmd.Flags().GetString("supabase-url")
+	cfg.Supabase.AnonKey, _ = cmd.Flags().GetString("supabase-anon-key")
+	if cfg.Supabase.ProjectURL != "" || cfg.Supabase.AnonKey != "" {
+		cfg.UseSupabase = true
+	}
+
+	if cmd.Flags().Changed("rest") {
+		cfg.UseRest, _ = cmd.Flags().GetBool("rest")
+	}
+	if baseURL, _ := cmd.Flags().GetString("rest-base-url"); baseURL != "" {
+		cfg.UseRest = true
+		cfg.Rest.BaseURL = baseURL
+	}
+	if cmd.Flags().Changed("rest-endpoints") {
+		endpoints, _ := cmd.Flags().GetStringToString("rest-endpoints")
+		if err := cfg.Rest.SetEndpoints(endpoints); err != nil {
+			return nil, err
+		}
+		cfg.UseRest = true
+	}
+
+	if provider, _ := cmd.Flags().GetString("notifications"); provider != "" {
+		cfg.EnableNotifications = true
+		cfg.NotificationService = provider
+		if provider == config.NotificationsFCM {
+			cfg.UseFirebase = true
+		}
+	}
+
+	cfg.SocialSignIn, _ = cmd.Flags().GetStringSlice("social")
+
+	return cfg, nil
+}
+
+// createFromManifest reads the configuration from a JSON manifest. The
+// --path and --force flags override the manifest when set.
+func createFromManifest(cmd *cobra.Command, path string) (*config.ProjectConfig, error) {
+	cfg, err := config.LoadManifest(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if cmd.Flags().Changed("path") {
+		cfg.TargetDirectory, _ = cmd.Flags().GetString("path")
+	}
+	if cmd.Flags().Changed("force") {
+		cfg.Force, _ = cmd.Flags().GetBool("force")
+	}
+
 	return cfg, nil
 }
 
@@ -366,6 +615,32 @@ func validateConfig(cfg *config.ProjectConfig) error {
 		return err
 	}
 
+	if err := utils.ValidateSupabaseURL(cfg.Supabase.ProjectURL); err != nil {
+		return err
+	}
+
+	if err := cfg.ValidateNotifications(); err != nil {
+		return err
+	}
+
+	if err := cfg.ValidateSocialSignIn(); err != nil {
+		return err
+	}
+
+	if err := cfg.ValidateRest(); err != nil {
+		return err
+	}
+
+	if err := cfg.ValidateScreens(); err != nil {
+		return err
+	}
+
+	for _, path := range []string{cfg.Firebase.AndroidConfig, cfg.Firebase.IOSConfig} {
+		if _, err := os.Stat(path); path != "" && err != nil {
+			return fmt.Errorf("Firebase configuration file '%s' not found", path)
+		}
+	}
+
 	// Set default target directory
 	if cfg.TargetDirectory == "" {
 		cfg.TargetDirectory = "."
@@ -416,15 +691,33 @@ func showProjectSummary(cfg *config.ProjectConfig) {
 	}
 
 	if cfg.UseFirebase {
-		items = append(items, ui.SuccessStyle.Render("✓")+" Firebase integration")
+		modules := strings.Join(cfg.Firebase.Modules(), ", ")
+		if modules == "" {
+			modules = "core only"
+		}
+		items = append(items, ui.SuccessStyle.Render("✓")+" Firebase integration ("+modules+")")
 	}
 
 	if cfg.UseSupabase {
-		items = append(items, ui.SuccessStyle.Render("✓")+" Supabase integration")
+		modules := strings.Join(cfg.Supabase.Modules(), ", ")
+		if modules == "" {
+			modules = "client only"
+		}
+		items = append(items, ui.SuccessStyle.Render("✓")+" Supabase integration ("+modules+")")
+		if cfg.Supabase.ProjectURL == "" || cfg.Supabase.AnonKey == "" {
+			items = append(items, ui.ErrorStyle.Render(ui.IconWarning)+" Supabase credentials missing: fill in env/dev.env and env/prod.env")
+		}
+	}
+
+	if cfg.UseRest {
+		items = append(items, ui.SuccessStyle.Render("✓")+" REST API ("+cfg.Rest.LoginPath+", "+cfg.Rest.RefreshPath+", "+cfg.Rest.LogoutPath+")")
+		if cfg.Rest.BaseURL == "" {
+			items = append(items, ui.ErrorStyle.Render(ui.IconWarning)+" REST base URL missing: pass --dart-define=API_BASE_URL=<url>")
+		}
 	}
 
 	if cfg.EnableNotifications {
-		items = append(items, ui.SuccessStyle.Render("✓")+" Push Notifications ("+cfg.NotificationService+")")
+		items = append(items, ui.SuccessStyle.Render("✓")+" Notifications ("+cfg.NotificationService+")")
 	}
 
 	if len(cfg.Models) > 0 {
@@ -448,10 +741,35 @@ func showProjectSummary(cfg *config.ProjectConfig) {
 	if cfg.GenerateSettingsScreen {
 		screens = append(screens, "Settings")
 	}
+	if cfg.GenerateSplashScreen {
+		screens = append(screens, "Splash")
+	}
+	if cfg.GenerateOnboardingScreen {
+		screens = append(screens, "Onboarding")
+	}
+	if cfg.GenerateShellScreen {
+		screens = append(screens, "Bottom navigation")
+	}
+	if cfg.GenerateStateViews {
+		screens = append(screens, "Empty & error states")
+	}
 
 	if len(screens) > 0 {
 		items = append(items, fmt.Sprintf("Screens: %s", strings.Join(screens, ", ")))
 	}
 
+	if len(cfg.SocialSignIn) > 0 {
+		items = append(items, ui.SuccessStyle.Render("✓")+" Social sign-in ("+strings.Join(cfg.SocialSignIn, ", ")+")")
+	}
+
+	if cfg.SkipPubGet {
+		items = append(items, ui.ErrorStyle.Render(ui.IconWarning)+" Skipping pub get and code generation")
+	} else if cfg.SkipCodegen {
+		items = append(items, ui.ErrorStyle.Render(ui.IconWarning)+" Skipping code generation")
+	}
+	if cfg.Offline {
+		items = append(items, "Offline: packages from the pub cache only")
+	}
+
 	logger.Box("Configuration", items)
 }
@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"fmt"
+
+	"fline-cli/internal/doctor"
+	"fline-cli/internal/ui"
+	"fline-cli/internal/utils"
+
+	"github.com/spf13/cobra"
+)
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check the current project against the Pine layout",
+	Long: `Audit an existing Flutter project against the layout generated by create.
+
+The report covers:
+  • Pine folder structure
+  • DI files, router and l10n.yaml
+  • Required pubspec dependencies and their version constraints
+  • Freshness of the code generated by build_runner
+
+Use --fix to create missing folders and core files. Existing files are never
+modified.
+
+Example:
+  pine doctor
+  pine doctor --fix`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE:         runDoctor,
+}
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+
+	doctorCmd.Flags().Bool("fix", false, "Apply the safe fixes (create missing folders and files)")
+}
+
+func runDoctor(cmd *cobra.Command, args []string) error {
+	logger := ui.NewLogger("doctor")
+
+	writer := utils.NewFileWriter(".")
+	if !writer.PathExists("pubspec.yaml") {
+		logger.Error("Not in a Flutter project directory")
+		logger.Info("Please run this command from your Flutter project root")
+		return fmt.Errorf("pubspec.yaml not found")
+	}
+
+	doc, err := doctor.New(".")
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to read the project: %s", err))
+		return err
+	}
+
+	report := doc.Run()
+	printReport(logger, report)
+
+	if fix, _ := cmd.Flags().GetBool("fix"); fix {
+		fixed, err := doc.Fix(report)
+		logger.NewLine()
+		for _, f := range fixed {
+			logger.Success("Fixed " + f.Title)
+		}
+		if err != nil {
+			logger.Error(err.Error())
+			return err
+		}
+		if len(fixed) == 0 {
+			logger.Info("Nothing to fix automatically")
+		} else {
+			report = doc.Run()
+			printScore(logger, report)
+		}
+	}
+
+	if failed := report.Count(doctor.StatusFail); failed > 0 {
+		return fmt.Errorf("%d of %d checks failed", failed, len(report.Findings))
+	}
+	return nil
+}
+
+func printReport(logger *ui.Logger, report *doctor.Report) {
+	logger.Title("Project health")
+
+	for _, category := range doctor.Categories {
+		var findings []doctor.Finding
+		for _, f := range report.Findings {
+			if f.Category == category {
+				findings = append(findings, f)
+			}
+		}
+		if len(findings) == 0 {
+			continue
+		}
+
+		passed := 0
+		for _, f := range findings {
+			if f.Status == doctor.StatusPass {
+				passed++
+			}
+		}
+
+		// Passed checks are summarized, problems are listed one by one
+		logger.Subtitle(fmt.Sprintf("%s (%d/%d)", category, passed, len(findings)))
+		if passed > 0 {
+			fmt.Println("  " + ui.SuccessStyle.Render(ui.IconSuccess) + fmt.Sprintf(" %d passed", passed))
+		}
+		for _, f := range findings {
+			switch f.Status {
+			case doctor.StatusWarn:
+				fmt.Println("  " + ui.ErrorStyle.Render(ui.IconWarning) + " " + f.Title + ui.MutedStyle.Render(" - "+f.Detail))
+			case doctor.StatusFail:
+				fmt.Println("  " + ui.ErrorStyle.Render(ui.IconError) + " " + f.Title + ui.MutedStyle.Render(" - "+f.Detail))
+			}
+		}
+		logger.NewLine()
+	}
+
+	if problems := report.Problems(); len(problems) > 0 {
+		items := []string{}
+		for _, f := range problems {
+			item := fmt.Sprintf("%s: %s", f.Title, f.Suggestion)
+			if f.Fixable() {
+				item += ui.MutedStyle.Render(" (--fix)")
+			}
+			items = append(items, item)
+		}
+		logger.Box("Suggested fixes", items)
+	}
+
+	printScore(logger, report)
+}
+
+func printScore(logger *ui.Logger, report *doctor.Report) {
+	summary := fmt.Sprintf("Score: %d/100 (%d passed, %d warnings, %d failed)",
+		report.Score(),
+		report.Count(doctor.StatusPass),
+		report.Count(doctor.StatusWarn),
+		report.Count(doctor.StatusFail),
+	)
+
+	if report.Score() == 100 {
+		logger.Success(summary)
+	} else {
+		logger.Info(summary)
+	}
+}
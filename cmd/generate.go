@@ -2,8 +2,11 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
 
 	"fline-cli/internal/generator"
+	"fline-cli/internal/pubspec"
+	"fline-cli/internal/templates"
 	"fline-cli/internal/ui"
 	"fline-cli/internal/utils"
 
@@ -22,17 +25,45 @@ You can generate:
   • BLoC (Business logic component)
   • All of the above
 
+Screens for a model created with fline model come from the screen
+subcommand.
+
 Example:
   pine generate user
-  pine generate product --type service`,
+  pine generate product --type service
+  pine generate screen --model user --kind list,detail`,
 	RunE: runGenerate,
 }
 
+var generateScreenCmd = &cobra.Command{
+	Use:   "screen",
+	Short: "Generate list, detail and form screens for a model",
+	Long: `Generate screens on the BLoC of a model created with fline model.
+
+Kinds:
+  • list    Paginated list with pull-to-refresh
+  • detail  Fields of an item, with edit and delete
+  • form    Create/edit form with a field per editable model field
+
+Screens generated together link to each other. Their strings are added to
+lib/l10n/app_en.arb and app_it.arb, their routes to lib/routers/app_router.dart.
+
+Example:
+  pine generate screen --model user
+  pine generate screen --model product --kind list,detail`,
+	Args: cobra.NoArgs,
+	RunE: runGenerateScreen,
+}
+
 func init() {
 	rootCmd.AddCommand(generateCmd)
 
 	generateCmd.Flags().StringP("name", "n", "", "Feature name")
 	generateCmd.Flags().StringP("type", "t", "all", "Component type (service, repository, bloc, all)")
+
+	generateCmd.AddCommand(generateScreenCmd)
+	generateScreenCmd.Flags().StringP("model", "m", "", "Model name, as given to fline model")
+	generateScreenCmd.Flags().StringSliceP("kind", "k", generator.ScreenKinds, "Screen kinds ("+strings.Join(generator.ScreenKinds, ", ")+")")
 }
 
 func runGenerate(cmd *cobra.Command, args []string) error {
@@ -99,16 +130,18 @@ func runGenerate(cmd *cobra.Command, args []string) error {
 	}
 
 	// Get package name from pubspec
-	packageName, err := getPackageName()
+	spec, err := loadPubspec()
 	if err != nil {
 		return err
 	}
+	packageName := spec.Name()
 
 	// Generate based on type
 	gen := &FeatureGenerator{
 		featureName: featureName,
 		packageName: packageName,
 		writer:      writer,
+		loader:      templates.NewLoader(writer.BaseDir()),
 		logger:      logger,
 	}
 
@@ -139,11 +172,87 @@ func runGenerate(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func runGenerateScreen(cmd *cobra.Command, args []string) error {
+	logger := ui.NewLogger("generate")
+
+	modelName, _ := cmd.Flags().GetString("model")
+	kinds, _ := cmd.Flags().GetStringSlice("kind")
+
+	// Interactive mode if no model provided
+	if modelName == "" {
+		form := huh.NewForm(
+			huh.NewGroup(
+				huh.NewInput().
+					Title("Model Name").
+					Description("A model generated with fline model, e.g. User").
+					Value(&modelName).
+					Validate(func(s string) error {
+						if s == "" {
+							return fmt.Errorf("model name is required")
+						}
+						return nil
+					}),
+
+				huh.NewMultiSelect[string]().
+					Title("Screens").
+					Options(
+						huh.NewOption("List (paginated, pull-to-refresh)", generator.ScreenKindList).Selected(true),
+						huh.NewOption("Detail", generator.ScreenKindDetail).Selected(true),
+						huh.NewOption("Form (create and edit)", generator.ScreenKindForm).Selected(true),
+					).
+					Value(&kinds),
+			).Title("🎨 Screen Generator"),
+		)
+
+		if err := form.Run(); err != nil {
+			return err
+		}
+	}
+
+	// Check if we're in a Flutter project
+	writer := utils.NewFileWriter(".")
+	if !writer.PathExists("pubspec.yaml") {
+		logger.Error("Not in a Flutter project directory")
+		logger.Info("Please run this command from your Flutter project root")
+		return fmt.Errorf("pubspec.yaml not found")
+	}
+
+	spec, err := loadPubspec()
+	if err != nil {
+		return err
+	}
+
+	gen := generator.NewModelScreenGenerator(modelName, spec.Name(), writer)
+	if err := gen.SetKinds(kinds); err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	logger.Info(fmt.Sprintf("Generating %s screens for model: %s", strings.Join(kinds, ", "), modelName))
+	if err := gen.Generate(); err != nil {
+		logger.Error(fmt.Sprintf("Generation failed: %s", err))
+		return err
+	}
+
+	logger.Success("Screens generated successfully!")
+	logger.NewLine()
+	logger.Box("Generated files:", gen.Files())
+	logger.NewLine()
+	logger.Info("Next steps:")
+	logger.Info("1. Run: flutter gen-l10n")
+	logger.Info("2. Run: flutter pub run build_runner build --delete-conflicting-outputs")
+	logger.Info(fmt.Sprintf("3. Add %sRepository to your dependency injector if it isn't there yet",
+		utils.NewNamingHelper(modelName).PascalCase()))
+
+	return nil
+}
+
 // FeatureGenerator generates feature components
 type FeatureGenerator struct {
 	featureName string
 	packageName string
 	writer      *utils.FileWriter
+	loader      *templates.Loader
 	logger      *ui.Logger
 }
 
@@ -163,49 +272,8 @@ func (g *FeatureGenerator) generateAll() error {
 func (g *FeatureGenerator) generateService() error {
 	naming := utils.NewNamingHelper(g.featureName)
 
-	content := fmt.Sprintf(`import 'package:dio/dio.dart';
-import 'package:retrofit/http.dart';
-
-part '%s_service.g.dart';
-
-@RestApi()
-abstract class %sService {
-  factory %sService(Dio dio) = _%sService;
-
-  @GET('/endpoint')
-  Future<List<dynamic>> get%ss();
-
-  @GET('/endpoint/{id}')
-  Future<dynamic> get%s(@Path('id') String id);
-
-  @POST('/endpoint')
-  Future<dynamic> create%s(@Body() Map<String, dynamic> data);
-
-  @PUT('/endpoint/{id}')
-  Future<dynamic> update%s(
-    @Path('id') String id,
-    @Body() Map<String, dynamic> data,
-  );
-
-  @DELETE('/endpoint/{id}')
-  Future<void> delete%s(@Path('id') String id);
-}
-`,
-		naming.SnakeCase(),
-		naming.PascalCase(),
-		naming.PascalCase(),
-		naming.PascalCase(),
-		naming.PascalCase(),
-		naming.PascalCase(),
-		naming.PascalCase(),
-		naming.PascalCase(),
-		naming.PascalCase(),
-	)
-
-	if err := g.writer.WriteFile(
-		fmt.Sprintf("lib/network/service/%s_service.dart", naming.SnakeCase()),
-		content,
-	); err != nil {
+	if err := g.render("feature/service.dart",
+		fmt.Sprintf("lib/network/service/%s_service.dart", naming.SnakeCase())); err != nil {
 		return err
 	}
 
@@ -216,87 +284,8 @@ abstract class %sService {
 func (g *FeatureGenerator) generateRepository() error {
 	naming := utils.NewNamingHelper(g.featureName)
 
-	content := fmt.Sprintf(`import 'package:logger/logger.dart';
-import 'package:%s/network/service/%s_service.dart';
-
-class %sRepository {
-  final %sService _service;
-  final Logger _logger;
-
-  %sRepository({
-    required %sService service,
-    required Logger logger,
-  })  : _service = service,
-        _logger = logger;
-
-  Future<List<dynamic>> getAll() async {
-    try {
-      return await _service.get%ss();
-    } catch (e) {
-      _logger.e('Error fetching %ss', error: e);
-      rethrow;
-    }
-  }
-
-  Future<dynamic> getById(String id) async {
-    try {
-      return await _service.get%s(id);
-    } catch (e) {
-      _logger.e('Error fetching %s', error: e);
-      rethrow;
-    }
-  }
-
-  Future<dynamic> create(Map<String, dynamic> data) async {
-    try {
-      return await _service.create%s(data);
-    } catch (e) {
-      _logger.e('Error creating %s', error: e);
-      rethrow;
-    }
-  }
-
-  Future<dynamic> update(String id, Map<String, dynamic> data) async {
-    try {
-      return await _service.update%s(id, data);
-    } catch (e) {
-      _logger.e('Error updating %s', error: e);
-      rethrow;
-    }
-  }
-
-  Future<void> delete(String id) async {
-    try {
-      await _service.delete%s(id);
-    } catch (e) {
-      _logger.e('Error deleting %s', error: e);
-      rethrow;
-    }
-  }
-}
-`,
-		g.packageName,
-		naming.SnakeCase(),
-		naming.PascalCase(),
-		naming.PascalCase(),
-		naming.PascalCase(),
-		naming.PascalCase(),
-		naming.PascalCase(),
-		naming.PascalCase(),
-		naming.PascalCase(),
-		naming.PascalCase(),
-		naming.PascalCase(),
-		naming.PascalCase(),
-		naming.PascalCase(),
-		naming.PascalCase(),
-		naming.PascalCase(),
-		naming.PascalCase(),
-	)
-
-	if err := g.writer.WriteFile(
-		fmt.Sprintf("lib/repositories/%s_repository.dart", naming.SnakeCase()),
-		content,
-	); err != nil {
+	if err := g.render("feature/repository.dart",
+		fmt.Sprintf("lib/repositories/%s_repository.dart", naming.SnakeCase())); err != nil {
 		return err
 	}
 
@@ -304,6 +293,17 @@ class %sRepository {
 	return nil
 }
 
+func (g *FeatureGenerator) render(name, path string) error {
+	content, err := g.loader.Render(name, templates.FeatureData{
+		PackageName: g.packageName,
+		Name:        templates.NewNames(g.featureName),
+	})
+	if err != nil {
+		return err
+	}
+	return g.writer.WriteFile(path, content)
+}
+
 func (g *FeatureGenerator) generateBloc() error {
 	naming := utils.NewNamingHelper(g.featureName)
 
@@ -324,51 +324,14 @@ func (g *FeatureGenerator) generateBloc() error {
 	return nil
 }
 
-func getPackageName() (string, error) {
-	writer := utils.NewFileWriter(".")
-	content, err := writer.ReadFile("pubspec.yaml")
+// loadPubspec reads the pubspec.yaml of the project in the current directory
+func loadPubspec() (*pubspec.Pubspec, error) {
+	spec, err := pubspec.Load("pubspec.yaml")
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-
-	// Simple parsing - look for "name: " line
-	for _, line := range splitLines(content) {
-		if len(line) > 6 && line[:5] == "name:" {
-			return trimSpace(line[5:]), nil
-		}
+	if spec.Name() == "" {
+		return nil, fmt.Errorf("could not find package name in pubspec.yaml")
 	}
-
-	return "", fmt.Errorf("could not find package name in pubspec.yaml")
-}
-
-func splitLines(s string) []string {
-	var lines []string
-	current := ""
-	for _, c := range s {
-		if c == '\n' {
-			lines = append(lines, current)
-			current = ""
-		} else {
-			current += string(c)
-		}
-	}
-	if current != "" {
-		lines = append(lines, current)
-	}
-	return lines
-}
-
-func trimSpace(s string) string {
-	start := 0
-	end := len(s)
-
-	for start < end && (s[start] == ' ' || s[start] == '\t') {
-		start++
-	}
-
-	for end > start && (s[end-1] == ' ' || s[end-1] == '\t' || s[end-1] == '\r') {
-		end--
-	}
-
-	return s[start:end]
+	return spec, nil
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 
 	"fline-cli/internal/generator"
 	"fline-cli/internal/ui"
@@ -24,9 +25,24 @@ This command creates:
   • Repository with error handling
   • BLoC with all CRUD operations
 
+With --source firestore the service is a typed data source over a Firestore
+collection (CRUD, query by field, realtime snapshots) and the BLoC can watch
+the collection. The project needs cloud_firestore (fline add firebase).
+The collection also gets a block in firestore.rules (owner and field type
+checks), set up with firestore.indexes.json, firebase.json and emulator
+tests in firestore-tests/.
+
+With --source supabase the service works on a Supabase table through
+SupabaseClient.from (select, insert, update, delete, realtime streams), and
+supabase/migrations/<timestamp>_<table>.sql creates the table with RLS
+policies. An existing migration for the table is never rewritten. The
+project needs supabase_flutter (fline add supabase).
+
 Example:
   pine model user --json '{"id": 1, "name": "John", "email": "john@example.com"}'
-  pine model product --json-file product.json`,
+  pine model product --json-file product.json
+  pine model order --json-file order.json --source firestore --collection orders
+  pine model task --json-file task.json --source supabase`,
 	RunE: runModel,
 }
 
@@ -37,6 +53,8 @@ func init() {
 	modelCmd.Flags().StringP("json", "j", "", "JSON string")
 	modelCmd.Flags().StringP("json-file", "f", "", "Path to JSON file")
 	modelCmd.Flags().StringP("endpoint", "e", "", "API endpoint (e.g., /api/users)")
+	modelCmd.Flags().String("source", generator.ModelSourceREST, "Data source ("+strings.Join(generator.ModelSources, ", ")+")")
+	modelCmd.Flags().String("collection", "", "Firestore collection or Supabase table (default: model name in plural)")
 }
 
 func runModel(cmd *cobra.Command, args []string) error {
@@ -57,6 +75,8 @@ func runModel(cmd *cobra.Command, args []string) error {
 	jsonStr, _ := cmd.Flags().GetString("json")
 	jsonFile, _ := cmd.Flags().GetString("json-file")
 	endpoint, _ := cmd.Flags().GetString("endpoint")
+	source, _ := cmd.Flags().GetString("source")
+	collection, _ := cmd.Flags().GetString("collection")
 
 	// Interactive mode if parameters missing
 	if modelName == "" || (jsonStr == "" && jsonFile == "") {
@@ -76,10 +96,22 @@ func runModel(cmd *cobra.Command, args []string) error {
 	}
 
 	// Get package name
-	packageName, err := getPackageName()
+	spec, err := loadPubspec()
 	if err != nil {
 		return err
 	}
+	packageName := spec.Name()
+
+	if source == generator.ModelSourceFirestore && !spec.HasDependency("cloud_firestore") {
+		logger.Error("cloud_firestore is not a dependency of this project")
+		logger.Info("Run: fline add firebase --modules firestore")
+		return fmt.Errorf("firestore source needs cloud_firestore")
+	}
+	if source == generator.ModelSourceSupabase && !spec.HasDependency("supabase_flutter") {
+		logger.Error("supabase_flutter is not a dependency of this project")
+		logger.Info("Run: fline add supabase")
+		return fmt.Errorf("supabase source needs supabase_flutter")
+	}
 
 	// Parse JSON
 	var jsonData map[string]interface{}
@@ -104,9 +136,6 @@ func runModel(cmd *cobra.Command, args []string) error {
 		endpoint = "/api/" + naming.KebabCase()
 	}
 
-	logger.Info(fmt.Sprintf("Generating model: %s", modelName))
-	logger.Info(fmt.Sprintf("Endpoint: %s", endpoint))
-
 	// Generate
 	gen := generator.NewModelGenerator(
 		modelName,
@@ -115,6 +144,20 @@ func runModel(cmd *cobra.Command, args []string) error {
 		packageName,
 		writer,
 	)
+	if err := gen.SetSource(source, collection); err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	logger.Info(fmt.Sprintf("Generating model: %s", modelName))
+	switch source {
+	case generator.ModelSourceFirestore:
+		logger.Info(fmt.Sprintf("Collection: %s", gen.Collection()))
+	case generator.ModelSourceSupabase:
+		logger.Info(fmt.Sprintf("Table: %s", gen.Collection()))
+	default:
+		logger.Info(fmt.Sprintf("Endpoint: %s", endpoint))
+	}
 
 	if err := gen.Generate(); err != nil {
 		logger.Error(fmt.Sprintf("Generation failed: %s", err))
@@ -123,16 +166,17 @@ func runModel(cmd *cobra.Command, args []string) error {
 
 	logger.Success("Model generated successfully!")
 	logger.NewLine()
-	logger.Box("Generated files:", []string{
-		fmt.Sprintf("lib/model/%s.dart", utils.NewNamingHelper(modelName).SnakeCase()),
-		fmt.Sprintf("lib/network/service/%s_service.dart", utils.NewNamingHelper(modelName).SnakeCase()),
-		fmt.Sprintf("lib/repositories/%s_repository.dart", utils.NewNamingHelper(modelName).SnakeCase()),
-		fmt.Sprintf("lib/state_management/bloc/%s/*", utils.NewNamingHelper(modelName).SnakeCase()),
-	})
+	logger.Box("Generated files:", gen.Files())
 	logger.NewLine()
 	logger.Info("Next steps:")
 	logger.Info("1. Run: flutter pub run build_runner build --delete-conflicting-outputs")
 	logger.Info("2. Add the service, repository, and BLoC to your dependency injector")
+	switch source {
+	case generator.ModelSourceFirestore:
+		logger.Info("3. Review firestore.rules, then run: firebase deploy --only firestore")
+	case generator.ModelSourceSupabase:
+		logger.Info("3. Review the migration, then run: supabase db push")
+	}
 
 	return nil
 }
@@ -0,0 +1,185 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"fline-cli/internal/generator"
+	"fline-cli/internal/plugin"
+	"fline-cli/internal/ui"
+	"fline-cli/internal/utils"
+
+	"github.com/spf13/cobra"
+)
+
+var pluginsCmd = &cobra.Command{
+	Use:   "plugins",
+	Short: "List generator plugins",
+	Long: `List the generator plugins available as subcommands.
+
+A plugin is any executable named fline-<name> found in .fline/plugins
+or on PATH. It is exposed as "fline <name>", receives the project context
+as JSON on stdin and answers on stdout with the files to write:
+
+  {"files": [{"path": "lib/...", "content": "..."}],
+   "messages": [{"level": "info", "text": "..."}]}
+
+Files are written relative to the project root. Existing files are kept
+unless --on-conflict (overwrite, skip or fail) says otherwise.`,
+	Args: cobra.NoArgs,
+	RunE: runPlugins,
+}
+
+func init() {
+	rootCmd.AddCommand(pluginsCmd)
+}
+
+// registerPlugin exposes the plugin named by the first argument as a
+// subcommand. Only that executable is looked up, and only when no built-in
+// command has the name, so that other commands never scan PATH.
+func registerPlugin(args []string) {
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") || args[0] == "help" {
+		return
+	}
+	for _, c := range rootCmd.Commands() {
+		if c.Name() == args[0] || c.HasAlias(args[0]) {
+			return
+		}
+	}
+
+	if p, ok := plugin.Find(".", args[0]); ok {
+		rootCmd.AddCommand(newPluginCommand(p))
+	}
+}
+
+func newPluginCommand(p plugin.Plugin) *cobra.Command {
+	return &cobra.Command{
+		Use:                p.Name,
+		Short:              fmt.Sprintf("Run the %s plugin", p.Name),
+		Long:               fmt.Sprintf("Run the %s plugin (%s).", p.Name, p.Path),
+		DisableFlagParsing: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPlugin(p, args)
+		},
+	}
+}
+
+func runPlugins(cmd *cobra.Command, args []string) error {
+	logger := ui.NewLogger("plugins")
+
+	plugins := plugin.Discover(".")
+	if len(plugins) == 0 {
+		logger.Info("No plugins found")
+		logger.Info(fmt.Sprintf("Add fline-<name> executables to %s or your PATH", plugin.ProjectPluginsDir))
+		return nil
+	}
+
+	items := []string{}
+	for _, p := range plugins {
+		items = append(items, fmt.Sprintf("%s → %s", p.Name, p.Path))
+	}
+	logger.Box("Plugins:", items)
+
+	return nil
+}
+
+func runPlugin(p plugin.Plugin, args []string) error {
+	logger := ui.NewLogger(p.Name)
+
+	policy, args, err := plugin.ExtractConflictPolicy(args)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	// Check if we're in a Flutter project
+	writer := utils.NewFileWriter(".")
+	if !writer.PathExists("pubspec.yaml") {
+		logger.Error("Not in a Flutter project directory")
+		logger.Info("Please run this command from your Flutter project root")
+		return fmt.Errorf("pubspec.yaml not found")
+	}
+
+	spec, err := loadPubspec()
+	if err != nil {
+		return err
+	}
+	packageName := spec.Name()
+	cfg, err := generator.ConfigFromProject(writer, spec)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	root, err := filepath.Abs(".")
+	if err != nil {
+		return err
+	}
+
+	resp, err := p.Run(plugin.Context{
+		ProtocolVersion: plugin.ProtocolVersion,
+		Command:         p.Name,
+		Args:            args,
+		Project: plugin.ProjectInfo{
+			Root:        root,
+			PackageName: packageName,
+			Pubspec:     filepath.Join(root, "pubspec.yaml"),
+			Lib:         filepath.Join(root, "lib"),
+		},
+		Config: cfg,
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	// Refuse before writing anything, so a conflict never leaves a partial result
+	if policy == utils.ConflictFail {
+		for _, f := range resp.Files {
+			if writer.HasConflict(f.Path, f.Content) {
+				err := &utils.ConflictError{Path: f.Path}
+				logger.Error(fmt.Sprintf("%s (use --on-conflict overwrite or skip)", err))
+				return err
+			}
+		}
+	}
+
+	writer.SetConflictPolicy(policy)
+	written := []string{}
+	for _, f := range resp.Files {
+		if err := writer.WriteFile(f.Path, f.Content); err != nil {
+			return err
+		}
+		written = append(written, f.Path)
+	}
+
+	for _, m := range resp.Messages {
+		switch m.Level {
+		case "success":
+			logger.Success(m.Text)
+		case "warning":
+			logger.Warning(m.Text)
+		default:
+			logger.Info(m.Text)
+		}
+	}
+
+	skipped := map[string]bool{}
+	for _, path := range writer.Skipped() {
+		skipped[path] = true
+		logger.Warning(fmt.Sprintf("Skipped existing file: %s", path))
+	}
+
+	files := []string{}
+	for _, path := range written {
+		if !skipped[path] {
+			files = append(files, path)
+		}
+	}
+	if len(files) > 0 {
+		logger.Box("Generated files:", files)
+	}
+
+	return nil
+}
@@ -1,8 +1,11 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"fline-cli/internal/ui"
 	"fline-cli/internal/utils"
@@ -24,21 +27,53 @@ It helps you create Flutter projects with:
 
 Made with ❤️ for Flutter developers`,
 	PersistentPreRun: func(cmd *cobra.Command, args []string) {
-		// Check if Flutter is installed
-		if err := utils.CheckFlutterInstalled(); err != nil {
+		if bin, _ := cmd.Flags().GetString("flutter-bin"); bin != "" {
+			utils.SetFlutterBinary(bin)
+		}
+
+		// Only commands that shell out to Flutter need the toolchain
+		reqs := requirementsOf(cmd)
+		if err := utils.CheckRequirements(reqs); err != nil {
 			logger := ui.NewLogger("pine")
-			logger.Error("Flutter is not installed or not in PATH")
+			logger.Error(fmt.Sprintf("'%s' needs a Flutter toolchain that is not available:", cmd.CommandPath()))
+			if tcErr, ok := err.(*utils.ToolchainError); ok {
+				for _, problem := range tcErr.Problems {
+					logger.Error("  " + problem)
+				}
+			} else {
+				logger.Error("  " + err.Error())
+			}
 			logger.Info("Please install Flutter: https://flutter.dev/docs/get-started/install")
+			logger.Info(fmt.Sprintf("Or point to it with --flutter-bin or $%s", utils.FlutterBinaryEnv))
 			os.Exit(1)
 		}
 	},
 }
 
+// toolchainRequirements records the external tools each command invokes
+var toolchainRequirements = map[*cobra.Command][]utils.Requirement{}
+
+// requireToolchain declares that cmd needs the given tools to run
+func requireToolchain(cmd *cobra.Command, reqs ...utils.Requirement) {
+	toolchainRequirements[cmd] = append(toolchainRequirements[cmd], reqs...)
+}
+
+// requirementsOf returns the toolchain requirements declared for cmd
+func requirementsOf(cmd *cobra.Command) []utils.Requirement {
+	return toolchainRequirements[cmd]
+}
+
 func Execute() {
 	// Print banner
 	ui.PrintBanner()
 
-	err := rootCmd.Execute()
+	registerPlugin(os.Args[1:])
+
+	// Ctrl-C cancels the running command and stops the toolchain processes
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	err := rootCmd.ExecuteContext(ctx)
 	if err != nil {
 		os.Exit(1)
 	}
@@ -47,6 +82,8 @@ func Execute() {
 func init() {
 	rootCmd.CompletionOptions.DisableDefaultCmd = true
 
+	rootCmd.PersistentFlags().String("flutter-bin", "", "Path to the flutter executable (default: $"+utils.FlutterBinaryEnv+" or flutter from PATH)")
+
 	// Add version info
 	rootCmd.Version = "1.0.0"
 	rootCmd.SetVersionTemplate(fmt.Sprintf("Pine CLI v%s\n", rootCmd.Version))
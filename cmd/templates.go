@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"fline-cli/internal/templates"
+	"fline-cli/internal/ui"
+	"fline-cli/internal/utils"
+
+	"github.com/spf13/cobra"
+)
+
+var templatesCmd = &cobra.Command{
+	Use:   "templates",
+	Short: "List, eject and validate code templates",
+	Long: `Manage the templates used to generate Dart code.
+
+Templates are looked up in this order:
+  1. .fline/templates/ in the current project
+  2. ~/.config/fline/templates/
+  3. built-in templates
+
+Example:
+  pine templates list
+  pine templates eject model/bloc.dart
+  pine templates check`,
+}
+
+var templatesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List built-in templates and the active override",
+	Args:  cobra.NoArgs,
+	RunE:  runTemplatesList,
+}
+
+var templatesEjectCmd = &cobra.Command{
+	Use:   "eject [name...]",
+	Short: "Copy built-in templates into .fline/templates for customization",
+	RunE:  runTemplatesEject,
+}
+
+var templatesCheckCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Render every template with sample data and report errors",
+	Args:  cobra.NoArgs,
+	RunE:  runTemplatesCheck,
+}
+
+func init() {
+	rootCmd.AddCommand(templatesCmd)
+	templatesCmd.AddCommand(templatesListCmd, templatesEjectCmd, templatesCheckCmd)
+
+	templatesEjectCmd.Flags().Bool("all", false, "Eject every built-in template")
+	templatesEjectCmd.Flags().BoolP("force", "f", false, "Overwrite templates that were already ejected")
+}
+
+func runTemplatesList(cmd *cobra.Command, args []string) error {
+	logger := ui.NewLogger("templates")
+	loader := templates.NewLoader(".")
+
+	logger.Title("Templates")
+
+	for _, t := range templates.Catalog {
+		src, err := loader.Resolve(t.Name)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("  %-38s %s\n", t.Name, ui.MutedStyle.Render(t.Description))
+		if src.Kind != templates.SourceBuiltin {
+			fmt.Println("    " + ui.SuccessStyle.Render(fmt.Sprintf("↳ %s override: %s", src.Kind, src.Path)))
+		}
+	}
+
+	logger.NewLine()
+	logger.Info(fmt.Sprintf("Project overrides: %s", loader.ProjectDir()))
+	logger.Info(fmt.Sprintf("User overrides: %s", templates.UserTemplatesDir()))
+
+	return nil
+}
+
+func runTemplatesEject(cmd *cobra.Command, args []string) error {
+	logger := ui.NewLogger("templates")
+
+	all, _ := cmd.Flags().GetBool("all")
+	force, _ := cmd.Flags().GetBool("force")
+
+	names := args
+	if all {
+		names = nil
+		for _, t := range templates.Catalog {
+			names = append(names, t.Name)
+		}
+	}
+
+	if len(names) == 0 {
+		return fmt.Errorf("specify a template name or --all (see: pine templates list)")
+	}
+
+	writer := utils.NewFileWriter(templates.ProjectTemplatesDir)
+	for _, name := range names {
+		content, err := templates.Builtin(name)
+		if err != nil {
+			logger.Error(err.Error())
+			return err
+		}
+
+		path := filepath.FromSlash(name) + templates.TemplateExt
+		if writer.PathExists(path) && !force {
+			logger.Warning(fmt.Sprintf("Skipped %s: already ejected (use --force to overwrite)", name))
+			continue
+		}
+
+		if err := writer.WriteFile(path, content); err != nil {
+			return err
+		}
+
+		logger.Success(fmt.Sprintf("Ejected %s → %s", name, writer.GetFullPath(path)))
+	}
+
+	return nil
+}
+
+func runTemplatesCheck(cmd *cobra.Command, args []string) error {
+	logger := ui.NewLogger("templates")
+	loader := templates.NewLoader(".")
+
+	logger.Title("Checking templates")
+
+	failures := 0
+	for _, t := range templates.Catalog {
+		src, err := loader.Resolve(t.Name)
+		if err != nil {
+			return err
+		}
+
+		if _, err := loader.Render(t.Name, t.SampleData()); err != nil {
+			logger.Error(fmt.Sprintf("%s (%s)", t.Name, src.Kind))
+			fmt.Println("    " + ui.MutedStyle.Render(err.Error()))
+			failures++
+			continue
+		}
+
+		if src.Kind != templates.SourceBuiltin {
+			logger.Success(fmt.Sprintf("%s (%s)", t.Name, src.Kind))
+		}
+	}
+
+	// Overrides that match no built-in template are never used
+	overrides, err := loader.Overrides()
+	if err != nil {
+		return err
+	}
+	sort.Slice(overrides, func(i, j int) bool {
+		return overrides[i].Name < overrides[j].Name
+	})
+	for _, o := range overrides {
+		if _, ok := templates.Lookup(o.Name); !ok {
+			logger.Warning(fmt.Sprintf("Unknown template %s is never used: %s", o.Name, o.Source.Path))
+		}
+	}
+
+	logger.NewLine()
+	if failures > 0 {
+		return fmt.Errorf("%d of %d templates failed", failures, len(templates.Catalog))
+	}
+
+	logger.Success(fmt.Sprintf("All %d templates rendered successfully", len(templates.Catalog)))
+	return nil
+}
@@ -1,61 +1,325 @@
 package config
 
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
 // ProjectConfig holds all configuration for project generation
 type ProjectConfig struct {
 	// Basic info
-	ProjectName      string
-	OrganizationName string
-	Description      string
-	TargetDirectory  string // Directory where to create the project
+	ProjectName      string `json:"projectName,omitempty"`
+	OrganizationName string `json:"organizationName,omitempty"`
+	Description      string `json:"description,omitempty"`
+	TargetDirectory  string `json:"targetDirectory,omitempty"` // Directory where to create the project
 
 	// Backend options
-	UseFirebase bool
-	UseSupabase bool
+	UseFirebase bool `json:"useFirebase"`
+	UseSupabase bool `json:"useSupabase"`
+	UseRest     bool `json:"useRest"` // Custom REST API with JWT auth
+
+	// Backend modules and settings, used when the backend is enabled
+	Firebase FirebaseConfig `json:"firebase"`
+	Supabase SupabaseConfig `json:"supabase"`
+	Rest     RestConfig     `json:"rest"`
 
 	// Features
-	EnableNotifications bool
-	NotificationService string // "fcm" or "onesignal"
+	EnableNotifications bool   `json:"enableNotifications"`
+	NotificationService string `json:"notificationService,omitempty"` // "fcm", "onesignal" or "local"
 
 	// Models to generate
-	Models []ModelConfig
+	Models []ModelConfig `json:"models,omitempty"`
 
 	// Screens to generate
-	GenerateLoginScreen    bool
-	GenerateHomeScreen     bool
-	GenerateProfileScreen  bool
-	GenerateSettingsScreen bool
+	GenerateLoginScreen    bool `json:"generateLoginScreen"`
+	GenerateHomeScreen     bool `json:"generateHomeScreen"`
+	GenerateProfileScreen  bool `json:"generateProfileScreen"`
+	GenerateSettingsScreen bool `json:"generateSettingsScreen"`
+
+	// App shell screens
+	GenerateSplashScreen     bool `json:"generateSplashScreen"`
+	GenerateOnboardingScreen bool `json:"generateOnboardingScreen"`
+	GenerateShellScreen      bool `json:"generateShellScreen"` // Bottom navigation between the home, profile and settings screens
+	GenerateStateViews       bool `json:"generateStateViews"`  // Shared empty and error state widgets
+
+	// Social providers of the login screen: google, apple and github
+	SocialSignIn []string `json:"socialSignIn,omitempty"`
 
 	// App context for CLAUDE.md (optional)
-	AppContext string
+	AppContext string `json:"appContext,omitempty"`
 
 	// Additional options
-	Force bool
+	Force         bool `json:"force"`
+	KeepOnFailure bool `json:"keepOnFailure"` // Keep the staging directory when generation fails
+
+	// Toolchain steps
+	SkipPubGet  bool `json:"skipPubGet"`  // Don't run flutter pub get (implies SkipCodegen)
+	SkipCodegen bool `json:"skipCodegen"` // Don't run gen-l10n and build_runner
+	Offline     bool `json:"offline"`     // Resolve packages from the pub cache only
+
+	// StepTimeout overrides the timeout of every toolchain step when set
+	StepTimeout Duration `json:"stepTimeout,omitempty"`
+}
+
+// Duration is a time.Duration written as a string such as "20m" in
+// manifests, like the --step-timeout flag
+type Duration time.Duration
+
+// MarshalJSON encodes the duration as a string
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// UnmarshalJSON decodes a duration string such as "90s" or "20m"
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("duration must be a string such as \"20m\"")
+	}
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(parsed)
+	return nil
 }
 
 // ModelConfig represents a model to generate
 type ModelConfig struct {
-	Name     string
-	JSONData map[string]interface{}
-	Endpoint string
+	Name     string                 `json:"name,omitempty"`
+	JSONData map[string]interface{} `json:"jsonData,omitempty"`
+	Endpoint string                 `json:"endpoint,omitempty"`
+
+	// Data source: rest (default), firestore or supabase. Collection names
+	// the Firestore collection or Supabase table, the model name in plural
+	// by default.
+	Source     string `json:"source,omitempty"`
+	Collection string `json:"collection,omitempty"`
 }
 
 // FirebaseConfig holds Firebase-specific configuration
 type FirebaseConfig struct {
-	EnableAuth         bool
-	EnableFirestore    bool
-	EnableStorage      bool
-	EnableMessaging    bool
-	EnableAnalytics    bool
-	EnableCrashlytics  bool
+	EnableAuth        bool `json:"enableAuth"`
+	EnableFirestore   bool `json:"enableFirestore"`
+	EnableStorage     bool `json:"enableStorage"`
+	EnableMessaging   bool `json:"enableMessaging"`
+	EnableAnalytics   bool `json:"enableAnalytics"`
+	EnableCrashlytics bool `json:"enableCrashlytics"`
+
+	// Paths of the google-services.json and GoogleService-Info.plist files
+	// to copy into the project, optional
+	AndroidConfig string `json:"androidConfig,omitempty"`
+	IOSConfig     string `json:"iosConfig,omitempty"`
+}
+
+// Firebase modules, as named on the command line
+const (
+	FirebaseAuth        = "auth"
+	FirebaseFirestore   = "firestore"
+	FirebaseStorage     = "storage"
+	FirebaseMessaging   = "messaging"
+	FirebaseAnalytics   = "analytics"
+	FirebaseCrashlytics = "crashlytics"
+)
+
+// FirebaseModules lists the Firebase modules in display order
+var FirebaseModules = []string{
+	FirebaseAuth,
+	FirebaseFirestore,
+	FirebaseStorage,
+	FirebaseMessaging,
+	FirebaseAnalytics,
+	FirebaseCrashlytics,
+}
+
+// DefaultFirebaseConfig returns the modules enabled unless others are
+// selected: authentication and Firestore
+func DefaultFirebaseConfig() FirebaseConfig {
+	return FirebaseConfig{EnableAuth: true, EnableFirestore: true}
+}
+
+// ParseFirebaseModules builds a FirebaseConfig enabling the named modules
+func ParseFirebaseModules(names []string) (FirebaseConfig, error) {
+	var f FirebaseConfig
+	err := enableModules(f.toggles(), names, "Firebase", FirebaseModules)
+	return f, err
+}
+
+// Has reports whether a module is enabled
+func (f FirebaseConfig) Has(module string) bool {
+	toggle, ok := f.toggles()[module]
+	return ok && *toggle
+}
+
+// Modules returns the names of the enabled modules
+func (f FirebaseConfig) Modules() []string {
+	return enabledModules(f.toggles(), FirebaseModules)
+}
+
+func (f *FirebaseConfig) toggles() map[string]*bool {
+	return map[string]*bool{
+		FirebaseAuth:        &f.EnableAuth,
+		FirebaseFirestore:   &f.EnableFirestore,
+		FirebaseStorage:     &f.EnableStorage,
+		FirebaseMessaging:   &f.EnableMessaging,
+		FirebaseAnalytics:   &f.EnableAnalytics,
+		FirebaseCrashlytics: &f.EnableCrashlytics,
+	}
 }
 
 // SupabaseConfig holds Supabase-specific configuration
 type SupabaseConfig struct {
-	ProjectURL string
-	AnonKey    string
-	EnableAuth bool
-	EnableDB   bool
-	EnableStorage bool
+	ProjectURL    string `json:"projectUrl,omitempty"`
+	AnonKey       string `json:"anonKey,omitempty"`
+	EnableAuth    bool   `json:"enableAuth"`
+	EnableDB      bool   `json:"enableDb"`
+	EnableStorage bool   `json:"enableStorage"`
+}
+
+// Supabase modules, as named on the command line
+const (
+	SupabaseAuth     = "auth"
+	SupabaseDatabase = "database"
+	SupabaseStorage  = "storage"
+)
+
+// SupabaseModules lists the Supabase modules in display order
+var SupabaseModules = []string{SupabaseAuth, SupabaseDatabase, SupabaseStorage}
+
+// DefaultSupabaseConfig returns the modules enabled unless others are
+// selected: authentication and the database
+func DefaultSupabaseConfig() SupabaseConfig {
+	return SupabaseConfig{EnableAuth: true, EnableDB: true}
+}
+
+// ParseSupabaseModules builds a SupabaseConfig enabling the named modules
+func ParseSupabaseModules(names []string) (SupabaseConfig, error) {
+	var s SupabaseConfig
+	err := enableModules(s.toggles(), names, "Supabase", SupabaseModules)
+	return s, err
+}
+
+// Has reports whether a module is enabled
+func (s SupabaseConfig) Has(module string) bool {
+	toggle, ok := s.toggles()[module]
+	return ok && *toggle
+}
+
+// Modules returns the names of the enabled modules
+func (s SupabaseConfig) Modules() []string {
+	return enabledModules(s.toggles(), SupabaseModules)
+}
+
+func (s *SupabaseConfig) toggles() map[string]*bool {
+	return map[string]*bool{
+		SupabaseAuth:     &s.EnableAuth,
+		SupabaseDatabase: &s.EnableDB,
+		SupabaseStorage:  &s.EnableStorage,
+	}
+}
+
+// RestConfig holds the settings of a custom REST API authenticating with
+// JWT access and refresh tokens. Paths are relative to the base URL.
+type RestConfig struct {
+	BaseURL           string `json:"baseUrl,omitempty"` // Default of --dart-define=API_BASE_URL
+	LoginPath         string `json:"loginPath,omitempty"`
+	RegisterPath      string `json:"registerPath,omitempty"`
+	RefreshPath       string `json:"refreshPath,omitempty"`
+	LogoutPath        string `json:"logoutPath,omitempty"`
+	PasswordResetPath string `json:"passwordResetPath,omitempty"`
+}
+
+// REST auth endpoints, as named on the command line
+const (
+	RestLogin         = "login"
+	RestRegister      = "register"
+	RestRefresh       = "refresh"
+	RestLogout        = "logout"
+	RestPasswordReset = "password-reset"
+)
+
+// RestEndpoints lists the REST auth endpoints in display order
+var RestEndpoints = []string{RestLogin, RestRegister, RestRefresh, RestLogout, RestPasswordReset}
+
+// DefaultRestConfig returns the endpoints used unless others are set
+func DefaultRestConfig() RestConfig {
+	return RestConfig{
+		LoginPath:         "/auth/login",
+		RegisterPath:      "/auth/register",
+		RefreshPath:       "/auth/refresh",
+		LogoutPath:        "/auth/logout",
+		PasswordResetPath: "/auth/password-reset",
+	}
+}
+
+// SetEndpoints overrides the paths of the named endpoints
+func (r *RestConfig) SetEndpoints(paths map[string]string) error {
+	endpoints := r.endpoints()
+	for name, path := range paths {
+		endpoint, ok := endpoints[name]
+		if !ok {
+			return fmt.Errorf("unknown REST endpoint %q (use %s)", name, strings.Join(RestEndpoints, ", "))
+		}
+		*endpoint = strings.TrimSpace(path)
+	}
+	return nil
+}
+
+// Validate checks that the paths can be written to Dart string literals
+func (r RestConfig) Validate() error {
+	endpoints := r.endpoints()
+	for _, name := range RestEndpoints {
+		path := *endpoints[name]
+		if !strings.HasPrefix(path, "/") || strings.ContainsAny(path, "'\\$ \n") {
+			return fmt.Errorf("invalid %s endpoint %q: use a path like /auth/%s", name, path, name)
+		}
+	}
+	if strings.ContainsAny(r.BaseURL, "'\\$ \n") {
+		return fmt.Errorf("invalid REST base URL %q", r.BaseURL)
+	}
+	return nil
+}
+
+func (r *RestConfig) endpoints() map[string]*string {
+	return map[string]*string{
+		RestLogin:         &r.LoginPath,
+		RestRegister:      &r.RegisterPath,
+		RestRefresh:       &r.RefreshPath,
+		RestLogout:        &r.LogoutPath,
+		RestPasswordReset: &r.PasswordResetPath,
+	}
+}
+
+// enableModules sets the toggles of the named modules
+func enableModules(toggles map[string]*bool, names []string, backend string, all []string) error {
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		toggle, ok := toggles[name]
+		if !ok {
+			return fmt.Errorf("unknown %s module %q (use %s)", backend, name, strings.Join(all, ", "))
+		}
+		*toggle = true
+	}
+	return nil
+}
+
+// enabledModules returns the enabled modules, in the order of all
+func enabledModules(toggles map[string]*bool, all []string) []string {
+	var modules []string
+	for _, module := range all {
+		if *toggles[module] {
+			modules = append(modules, module)
+		}
+	}
+	return modules
 }
 
 // DefaultProjectConfig returns a new config with sensible defaults
@@ -63,6 +327,9 @@ func DefaultProjectConfig() *ProjectConfig {
 	return &ProjectConfig{
 		OrganizationName:       "com.example",
 		TargetDirectory:        ".",
+		Firebase:               DefaultFirebaseConfig(),
+		Supabase:               DefaultSupabaseConfig(),
+		Rest:                   DefaultRestConfig(),
 		EnableNotifications:    false,
 		GenerateLoginScreen:    true,
 		GenerateHomeScreen:     true,
@@ -72,3 +339,165 @@ func DefaultProjectConfig() *ProjectConfig {
 		Force:                  false,
 	}
 }
+
+// Auth backends the generated login screens sign in with
+const (
+	AuthFirebase = "firebase"
+	AuthSupabase = "supabase"
+	AuthRest     = "rest"
+)
+
+// AuthBackend returns the backend whose auth module the login screens use,
+// Firebase first, or an empty string when no auth module is enabled. The
+// custom REST API always authenticates.
+func (c *ProjectConfig) AuthBackend() string {
+	switch {
+	case c.UseFirebase && c.Firebase.EnableAuth:
+		return AuthFirebase
+	case c.UseSupabase && c.Supabase.EnableAuth:
+		return AuthSupabase
+	case c.UseRest:
+		return AuthRest
+	}
+	return ""
+}
+
+// ValidateRest checks the endpoints of the custom REST API and that no
+// other backend authenticates the user
+func (c *ProjectConfig) ValidateRest() error {
+	if !c.UseRest {
+		return nil
+	}
+	if c.AuthBackend() != AuthRest {
+		return fmt.Errorf("the custom REST API authenticates the user, disable the %s auth module", c.AuthBackend())
+	}
+	return c.Rest.Validate()
+}
+
+// Social sign-in providers, as named on the command line
+const (
+	SocialGoogle = "google"
+	SocialApple  = "apple"
+	SocialGitHub = "github"
+)
+
+// SocialProviders lists the social sign-in providers in display order
+var SocialProviders = []string{SocialGoogle, SocialApple, SocialGitHub}
+
+// ValidateSocialSignIn checks that the social providers are known and that
+// the login screen and an auth module are there to use them
+func (c *ProjectConfig) ValidateSocialSignIn() error {
+	if len(c.SocialSignIn) == 0 {
+		return nil
+	}
+	for _, provider := range c.SocialSignIn {
+		if !slices.Contains(SocialProviders, provider) {
+			return fmt.Errorf("unknown social sign-in provider %q (use %s)", provider, strings.Join(SocialProviders, ", "))
+		}
+	}
+	if !c.GenerateLoginScreen {
+		return fmt.Errorf("social sign-in needs the login screen")
+	}
+	if backend := c.AuthBackend(); backend != AuthFirebase && backend != AuthSupabase {
+		return fmt.Errorf("social sign-in needs the Firebase or Supabase auth module")
+	}
+	return nil
+}
+
+// HasSocialSignIn reports whether the login screen offers a social provider
+func (c *ProjectConfig) HasSocialSignIn(provider string) bool {
+	return slices.Contains(c.SocialSignIn, provider)
+}
+
+// Notification providers, as named on the command line
+const (
+	NotificationsFCM       = "fcm"
+	NotificationsOneSignal = "onesignal"
+	NotificationsLocal     = "local"
+)
+
+// NotificationServices lists the notification providers in display order
+var NotificationServices = []string{NotificationsFCM, NotificationsOneSignal, NotificationsLocal}
+
+// Normalize turns on the options implied by the ones that are set
+func (c *ProjectConfig) Normalize() {
+	// Login opens the home screen once signed in
+	if c.GenerateLoginScreen {
+		c.GenerateHomeScreen = true
+	}
+	// Login and onboarding are reached from the splash screen
+	if c.GenerateLoginScreen || c.GenerateOnboardingScreen {
+		c.GenerateSplashScreen = true
+	}
+	// Shell opens on the home tab
+	if c.GenerateShellScreen {
+		c.GenerateHomeScreen = true
+	}
+	// Notifications without a provider: FCM with Firebase, local otherwise
+	if c.EnableNotifications && c.NotificationService == "" {
+		c.NotificationService = NotificationsLocal
+		if c.UseFirebase {
+			c.NotificationService = NotificationsFCM
+		}
+	}
+	if !c.UseFirebase {
+		return
+	}
+	// FCM notifications and the messaging module imply each other
+	if c.EnableNotifications && c.NotificationService == NotificationsFCM {
+		c.Firebase.EnableMessaging = true
+	}
+	if c.Firebase.EnableMessaging && !c.EnableNotifications {
+		c.EnableNotifications = true
+		c.NotificationService = NotificationsFCM
+	}
+}
+
+// ValidateScreens checks that the bottom navigation shell has a tab besides
+// the home screen
+func (c *ProjectConfig) ValidateScreens() error {
+	if c.GenerateShellScreen && !c.GenerateProfileScreen && !c.GenerateSettingsScreen {
+		return fmt.Errorf("the bottom navigation shell needs the profile or settings screen as a second tab")
+	}
+	return nil
+}
+
+// ValidateNotifications checks that the notification provider is known and
+// that its backend is there
+func (c *ProjectConfig) ValidateNotifications() error {
+	if !c.EnableNotifications {
+		return nil
+	}
+	switch c.NotificationService {
+	case NotificationsFCM:
+		if !c.UseFirebase {
+			return fmt.Errorf("FCM notifications need Firebase")
+		}
+	case NotificationsOneSignal, NotificationsLocal:
+		if c.UseFirebase && c.Firebase.EnableMessaging {
+			return fmt.Errorf("the Firebase messaging module sends notifications through FCM, not %s", c.NotificationService)
+		}
+	default:
+		return fmt.Errorf("unknown notification provider %q (use %s)", c.NotificationService, strings.Join(NotificationServices, ", "))
+	}
+	return nil
+}
+
+// LoadManifest reads a project configuration from a JSON manifest. Options
+// missing from the manifest keep their default value.
+func LoadManifest(path string) (*ProjectConfig, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := DefaultProjectConfig()
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(cfg); err != nil {
+		return nil, fmt.Errorf("invalid manifest %s: %w", path, err)
+	}
+
+	cfg.Normalize()
+	return cfg, nil
+}
@@ -0,0 +1,297 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseFirebaseModules(t *testing.T) {
+	f, err := ParseFirebaseModules([]string{"storage", " auth", "crashlytics"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := FirebaseConfig{EnableAuth: true, EnableStorage: true, EnableCrashlytics: true}
+	if f != want {
+		t.Errorf("ParseFirebaseModules() = %+v, want %+v", f, want)
+	}
+	if got := f.Modules(); !reflect.DeepEqual(got, []string{"auth", "storage", "crashlytics"}) {
+		t.Errorf("Modules() = %q", got)
+	}
+
+	if _, err := ParseFirebaseModules([]string{"functions"}); err == nil {
+		t.Error("expected an error for an unknown module")
+	}
+}
+
+func TestNormalizeMessaging(t *testing.T) {
+	cfg := DefaultProjectConfig()
+	cfg.UseFirebase = true
+	cfg.EnableNotifications = true
+	cfg.NotificationService = NotificationsFCM
+	cfg.Normalize()
+	if !cfg.Firebase.EnableMessaging {
+		t.Error("FCM notifications should enable the messaging module")
+	}
+
+	cfg = DefaultProjectConfig()
+	cfg.UseFirebase = true
+	cfg.Firebase.EnableMessaging = true
+	cfg.Normalize()
+	if !cfg.EnableNotifications || cfg.NotificationService != NotificationsFCM {
+		t.Errorf("messaging should enable FCM notifications, got %v %q", cfg.EnableNotifications, cfg.NotificationService)
+	}
+}
+
+func TestNormalizeNotificationService(t *testing.T) {
+	cfg := DefaultProjectConfig()
+	cfg.EnableNotifications = true
+	cfg.Normalize()
+	if cfg.NotificationService != NotificationsLocal {
+		t.Errorf("without Firebase, NotificationService = %q, want local", cfg.NotificationService)
+	}
+
+	// Another provider doesn't turn the messaging module on
+	cfg = DefaultProjectConfig()
+	cfg.UseFirebase = true
+	cfg.EnableNotifications = true
+	cfg.NotificationService = NotificationsOneSignal
+	cfg.Normalize()
+	if cfg.Firebase.EnableMessaging || cfg.NotificationService != NotificationsOneSignal {
+		t.Errorf("OneSignal changed to %q, messaging %v", cfg.NotificationService, cfg.Firebase.EnableMessaging)
+	}
+}
+
+func TestAuthBackend(t *testing.T) {
+	cfg := DefaultProjectConfig()
+	if got := cfg.AuthBackend(); got != "" {
+		t.Errorf("without a backend, AuthBackend() = %q", got)
+	}
+
+	cfg.UseSupabase = true
+	cfg.Supabase.EnableAuth = true
+	if got := cfg.AuthBackend(); got != AuthSupabase {
+		t.Errorf("AuthBackend() = %q, want supabase", got)
+	}
+
+	cfg.Supabase.EnableAuth = false
+	if got := cfg.AuthBackend(); got != "" {
+		t.Errorf("without the auth module, AuthBackend() = %q", got)
+	}
+}
+
+func TestNormalizeLoginNeedsHome(t *testing.T) {
+	cfg := DefaultProjectConfig()
+	cfg.GenerateLoginScreen = true
+	cfg.GenerateHomeScreen = false
+	cfg.Normalize()
+	if !cfg.GenerateHomeScreen {
+		t.Error("the login screen should enable the home screen it navigates to")
+	}
+}
+
+func TestNormalizeShellScreens(t *testing.T) {
+	cfg := DefaultProjectConfig()
+	cfg.GenerateLoginScreen = false
+	cfg.GenerateHomeScreen = false
+	cfg.Normalize()
+	if cfg.GenerateSplashScreen {
+		t.Error("the splash screen should stay off without login and onboarding")
+	}
+
+	cfg.GenerateOnboardingScreen = true
+	cfg.GenerateShellScreen = true
+	cfg.Normalize()
+	if !cfg.GenerateSplashScreen {
+		t.Error("the onboarding should enable the splash screen that opens it")
+	}
+	if !cfg.GenerateHomeScreen {
+		t.Error("the shell should enable the home screen of its first tab")
+	}
+}
+
+func TestValidateScreens(t *testing.T) {
+	cfg := DefaultProjectConfig()
+	cfg.GenerateShellScreen = true
+	if err := cfg.ValidateScreens(); err == nil {
+		t.Error("expected an error for a shell with the home tab only")
+	}
+
+	cfg.GenerateSettingsScreen = true
+	if err := cfg.ValidateScreens(); err != nil {
+		t.Errorf("ValidateScreens() error = %v", err)
+	}
+}
+
+func TestValidateSocialSignIn(t *testing.T) {
+	tests := []struct {
+		name      string
+		configure func(*ProjectConfig)
+		wantErr   bool
+	}{
+		{"none", func(c *ProjectConfig) {}, false},
+		{"firebase", func(c *ProjectConfig) {
+			c.UseFirebase = true
+			c.SocialSignIn = []string{SocialGoogle, SocialApple}
+		}, false},
+		{"supabase", func(c *ProjectConfig) {
+			c.UseSupabase = true
+			c.SocialSignIn = []string{SocialGitHub}
+		}, false},
+		{"no auth module", func(c *ProjectConfig) {
+			c.SocialSignIn = []string{SocialGoogle}
+		}, true},
+		{"no login screen", func(c *ProjectConfig) {
+			c.UseFirebase = true
+			c.GenerateLoginScreen = false
+			c.SocialSignIn = []string{SocialGoogle}
+		}, true},
+		{"unknown provider", func(c *ProjectConfig) {
+			c.UseFirebase = true
+			c.SocialSignIn = []string{"facebook"}
+		}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultProjectConfig()
+			tt.configure(cfg)
+			if err := cfg.ValidateSocialSignIn(); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateSocialSignIn() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateRest(t *testing.T) {
+	cfg := DefaultProjectConfig()
+	cfg.UseRest = true
+	if err := cfg.ValidateRest(); err != nil {
+		t.Errorf("default endpoints: ValidateRest() error = %v", err)
+	}
+	if got := cfg.AuthBackend(); got != AuthRest {
+		t.Errorf("AuthBackend() = %q, want rest", got)
+	}
+
+	if err := cfg.Rest.SetEndpoints(map[string]string{RestRefresh: "/v1/token"}); err != nil {
+		t.Fatalf("SetEndpoints() error = %v", err)
+	}
+	if cfg.Rest.RefreshPath != "/v1/token" || cfg.Rest.LoginPath != "/auth/login" {
+		t.Errorf("SetEndpoints() = %+v", cfg.Rest)
+	}
+	if err := cfg.Rest.SetEndpoints(map[string]string{"me": "/me"}); err == nil {
+		t.Error("SetEndpoints() accepted an unknown endpoint")
+	}
+
+	cfg.Rest.LogoutPath = "auth/logout"
+	if err := cfg.ValidateRest(); err == nil {
+		t.Error("ValidateRest() accepted a path without a leading slash")
+	}
+
+	// Another auth module would take over the login screens
+	cfg = DefaultProjectConfig()
+	cfg.UseRest = true
+	cfg.UseFirebase = true
+	if err := cfg.ValidateRest(); err == nil {
+		t.Error("ValidateRest() accepted the Firebase auth module")
+	}
+}
+
+func TestValidateNotifications(t *testing.T) {
+	tests := []struct {
+		name      string
+		firebase  bool
+		messaging bool
+		service   string
+		wantErr   bool
+	}{
+		{name: "local", service: NotificationsLocal},
+		{name: "onesignal with Firebase", firebase: true, service: NotificationsOneSignal},
+		{name: "fcm", firebase: true, messaging: true, service: NotificationsFCM},
+		{name: "fcm without Firebase", service: NotificationsFCM, wantErr: true},
+		{name: "onesignal with messaging", firebase: true, messaging: true, service: NotificationsOneSignal, wantErr: true},
+		{name: "unknown", service: "pusher", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultProjectConfig()
+			cfg.UseFirebase = tt.firebase
+			cfg.Firebase.EnableMessaging = tt.messaging
+			cfg.EnableNotifications = true
+			cfg.NotificationService = tt.service
+			if err := cfg.ValidateNotifications(); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateNotifications() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadManifest(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fline.json")
+	manifest := `{
+  "projectName": "shop",
+  "useFirebase": true,
+  "firebase": {"enableStorage": true, "enableMessaging": true},
+  "stepTimeout": "20m"
+}`
+	if err := os.WriteFile(path, []byte(manifest), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadManifest(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.ProjectName != "shop" || cfg.OrganizationName != "com.example" {
+		t.Errorf("unexpected config %+v", cfg)
+	}
+	// Modules missing from the manifest keep their default
+	want := []string{"auth", "firestore", "storage", "messaging"}
+	if got := cfg.Firebase.Modules(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Modules() = %q, want %q", got, want)
+	}
+	if cfg.NotificationService != NotificationsFCM {
+		t.Errorf("NotificationService = %q, want fcm", cfg.NotificationService)
+	}
+	if cfg.StepTimeout != Duration(20*time.Minute) {
+		t.Errorf("StepTimeout = %v, want 20m", time.Duration(cfg.StepTimeout))
+	}
+
+	// Durations are strings, not nanoseconds
+	if err := os.WriteFile(path, []byte(`{"stepTimeout": 1200000000000}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadManifest(path); err == nil {
+		t.Error("expected an error for a numeric step timeout")
+	}
+
+	if err := os.WriteFile(path, []byte(`{"projectNme": "shop"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadManifest(path); err == nil {
+		t.Error("expected an error for an unknown field")
+	}
+}
+
+func TestDurationJSON(t *testing.T) {
+	content, err := json.Marshal(ProjectConfig{StepTimeout: Duration(90 * time.Second)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(content), `"stepTimeout":"1m30s"`) {
+		t.Errorf("Marshal() = %s, want stepTimeout 1m30s", content)
+	}
+
+	var cfg ProjectConfig
+	if err := json.Unmarshal(content, &cfg); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.StepTimeout != Duration(90*time.Second) {
+		t.Errorf("StepTimeout = %v, want 1m30s", time.Duration(cfg.StepTimeout))
+	}
+}
@@ -0,0 +1,268 @@
+// Package dartedit applies small, idempotent edits to Dart source files:
+// adding imports, adding entries to a list literal, adding routes to an
+// auto_route router and adding statements to main(). Every function
+// reports whether the source changed, so running the same edit twice
+// leaves the file untouched.
+package dartedit
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ensureInitialized must run before any plugin is used in main()
+const ensureInitialized = "WidgetsFlutterBinding.ensureInitialized();"
+
+var (
+	importPattern = regexp.MustCompile(`(?m)^import\s+'([^']+)'[^\n]*;[ \t]*$`)
+	mainPattern   = regexp.MustCompile(`(?m)^(?:void|Future<void>)\s+main\(\)\s*(?:async\s*)?\{`)
+	routesPattern = regexp.MustCompile(`\broutes\s*=>\s*(?:const\s*)?\[`)
+)
+
+// HasImport reports whether source imports uri
+func HasImport(source, uri string) bool {
+	for _, match := range importPattern.FindAllStringSubmatch(source, -1) {
+		if match[1] == uri {
+			return true
+		}
+	}
+	return false
+}
+
+// AddImport adds an import of uri, keeping dart: imports first, then
+// package: imports, then relative ones, each group in alphabetical order
+// when it already was.
+func AddImport(source, uri string) (string, bool) {
+	if HasImport(source, uri) {
+		return source, false
+	}
+
+	directive := fmt.Sprintf("import '%s';\n", uri)
+	matches := importPattern.FindAllStringSubmatchIndex(source, -1)
+	if len(matches) == 0 {
+		// Keep library and part of directives first
+		return insertAfterDirectives(source, directive), true
+	}
+
+	for _, m := range matches {
+		if importLess(uri, source[m[2]:m[3]]) {
+			return source[:m[0]] + directive + source[m[0]:], true
+		}
+	}
+
+	end := matches[len(matches)-1][1]
+	if end < len(source) && source[end] == '\n' {
+		end++
+		return source[:end] + directive + source[end:], true
+	}
+	return source[:end] + "\n" + strings.TrimSuffix(directive, "\n") + source[end:], true
+}
+
+// importLess orders import URIs the way dart format and the lints expect
+func importLess(a, b string) bool {
+	rank := func(uri string) int {
+		switch {
+		case strings.HasPrefix(uri, "dart:"):
+			return 0
+		case strings.HasPrefix(uri, "package:"):
+			return 1
+		default:
+			return 2
+		}
+	}
+	if rank(a) != rank(b) {
+		return rank(a) < rank(b)
+	}
+	return a < b
+}
+
+// insertAfterDirectives inserts text after the leading library/part of
+// directive, or at the top of the file
+func insertAfterDirectives(source, text string) string {
+	pattern := regexp.MustCompile(`(?m)^(?:library|part of)\b[^\n]*;[ \t]*\n`)
+	if loc := pattern.FindStringIndex(source); loc != nil {
+		return source[:loc[1]] + "\n" + text + source[loc[1]:]
+	}
+	return text + "\n" + source
+}
+
+// AddListItem appends item (one or more lines, without indentation) to the
+// list literal assigned to name, e.g. "_providers" in
+//
+//	final List<SingleChildWidget> _providers = [
+//
+// key identifies the item: nothing is added when the list already contains
+// it.
+func AddListItem(source, name, key, item string) (string, bool, error) {
+	pattern := regexp.MustCompile(`\b` + regexp.QuoteMeta(name) + `\s*=\s*(?:const\s*)?\[`)
+	loc := pattern.FindStringIndex(source)
+	if loc == nil {
+		return source, false, fmt.Errorf("list %s not found", name)
+	}
+
+	open := loc[1] - 1
+	end := matchingBracket(source, open)
+	if end < 0 {
+		return source, false, fmt.Errorf("list %s is not closed", name)
+	}
+	if strings.Contains(source[open:end], key) {
+		return source, false, nil
+	}
+
+	// Entries are separated by a blank line, the closing bracket keeps its
+	// own line
+	before := strings.TrimRight(source[:end], " \t\n")
+	after := source[end:]
+	if lineStart := strings.LastIndex(source[:end], "\n"); lineStart >= len(before) {
+		after = source[lineStart+1:]
+	}
+
+	// An item follows the previous one after a comma and a blank line, a
+	// comment (e.g. "// Add your BLoCs here") or the bracket right away
+	lastLine := strings.TrimSpace(before[strings.LastIndex(before, "\n")+1:])
+	separator := "\n"
+	if !strings.HasSuffix(before, "[") && !strings.HasPrefix(lastLine, "//") {
+		if !strings.HasSuffix(before, ",") {
+			before += ","
+		}
+		separator = "\n\n"
+	}
+
+	lines := strings.Split(strings.TrimRight(item, "\n"), "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = "  " + line
+		}
+	}
+
+	return before + separator + strings.Join(lines, "\n") + "\n" + after, true, nil
+}
+
+// AddRoute appends route, a single line, to the routes list of an auto_route
+// router:
+//
+//	List<AutoRoute> get routes => [
+//
+// The route gets the indentation of the last one. key identifies the route,
+// e.g. "UserListRoute.page": nothing is added when the list already contains
+// it.
+func AddRoute(source, key, route string) (string, bool, error) {
+	loc := routesPattern.FindStringIndex(source)
+	if loc == nil {
+		return source, false, fmt.Errorf("routes list not found")
+	}
+
+	open := loc[1] - 1
+	end := matchingBracket(source, open)
+	if end < 0 {
+		return source, false, fmt.Errorf("routes list is not closed")
+	}
+	if strings.Contains(source[open:end], key) {
+		return source, false, nil
+	}
+
+	before := strings.TrimRight(source[:end], " \t\n")
+	lineStart := strings.LastIndex(source[:end], "\n")
+	closing := source[lineStart+1 : end] // Indentation of the bracket
+	if lineStart < len(before) || strings.TrimSpace(closing) != "" {
+		closing = "" // The bracket closes the last line
+	}
+	after := "\n" + closing + source[end:]
+
+	indent := closing + "  "
+	if !strings.HasSuffix(before, "[") {
+		last := before[strings.LastIndex(before, "\n")+1:]
+		indent = last[:len(last)-len(strings.TrimLeft(last, " \t"))]
+		if !strings.HasSuffix(before, ",") {
+			before += ","
+		}
+	}
+
+	return before + "\n" + indent + route + "," + after, true, nil
+}
+
+// matchingBracket returns the index of the bracket closing the one at open,
+// skipping string literals and comments, or -1
+func matchingBracket(source string, open int) int {
+	pairs := map[byte]byte{'[': ']', '(': ')', '{': '}'}
+	var stack []byte
+
+	for i := open; i < len(source); i++ {
+		c := source[i]
+		switch {
+		case c == '\'' || c == '"':
+			// Skip the string literal
+			for i++; i < len(source) && source[i] != c; i++ {
+				if source[i] == '\\' {
+					i++
+				}
+			}
+		case strings.HasPrefix(source[i:], "//"):
+			for i < len(source) && source[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(source[i:], "/*"):
+			end := strings.Index(source[i+2:], "*/")
+			if end < 0 {
+				return -1
+			}
+			i += end + 3
+		case pairs[c] != 0:
+			stack = append(stack, pairs[c])
+		case c == ']' || c == ')' || c == '}':
+			if len(stack) == 0 || stack[len(stack)-1] != c {
+				return -1
+			}
+			stack = stack[:len(stack)-1]
+			if len(stack) == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// AddMainStatement adds statement to main() right before runApp, turning
+// main into an async function that calls
+// WidgetsFlutterBinding.ensureInitialized() first. A statement spanning
+// several lines is given without indentation. Nothing is added when main
+// already contains the first line of the statement.
+func AddMainStatement(source, statement string) (string, bool, error) {
+	loc := mainPattern.FindStringIndex(source)
+	if loc == nil {
+		return source, false, fmt.Errorf("no main() function with a block body found")
+	}
+	open := loc[1] - 1
+	end := matchingBracket(source, open)
+	if end < 0 {
+		return source, false, fmt.Errorf("main() is not closed")
+	}
+	lines := strings.Split(strings.TrimRight(statement, "\n"), "\n")
+	if strings.Contains(source[open:end], lines[0]) {
+		return source, false, nil
+	}
+
+	header := "Future<void> main() async {"
+	body := source[open+1 : end]
+
+	if !strings.Contains(body, ensureInitialized) {
+		body = "\n  " + ensureInitialized + body
+	}
+
+	// Insert before the runApp line, keeping its indentation, or after the
+	// last statement
+	if i := strings.Index(body, "runApp("); i >= 0 {
+		lineStart := strings.LastIndex(body[:i], "\n") + 1
+		if indent := body[lineStart:i]; lineStart > 0 && strings.TrimSpace(indent) == "" {
+			body = body[:lineStart] + indent + strings.Join(lines, "\n"+indent) + "\n" + body[lineStart:]
+		} else {
+			body = body[:i] + strings.Join(lines, " ") + " " + body[i:]
+		}
+	} else {
+		last := len(strings.TrimRight(body, " \t\n"))
+		body = body[:last] + "\n  " + strings.Join(lines, "\n  ") + body[last:]
+	}
+
+	return source[:loc[0]] + header + body + source[end:], true, nil
+}
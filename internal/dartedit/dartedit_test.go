@@ -0,0 +1,211 @@
+package dartedit
+
+import "testing"
+
+const mainSource = `import 'package:flutter/material.dart';
+import 'app.dart';
+
+void main() {
+  runApp(const App());
+}
+`
+
+func TestAddImport(t *testing.T) {
+	got, changed := AddImport(mainSource, "package:demo_app/utils/firebase_initializer.dart")
+	want := `import 'package:demo_app/utils/firebase_initializer.dart';
+import 'package:flutter/material.dart';
+import 'app.dart';
+
+void main() {
+  runApp(const App());
+}
+`
+	if !changed || got != want {
+		t.Fatalf("AddImport() = %v\n%s", changed, got)
+	}
+
+	got, changed = AddImport(got, "utils/setup.dart")
+	if !changed || !HasImport(got, "utils/setup.dart") {
+		t.Fatalf("relative import not added:\n%s", got)
+	}
+	if again, changed := AddImport(got, "utils/setup.dart"); changed || again != got {
+		t.Fatalf("AddImport() is not idempotent:\n%s", again)
+	}
+}
+
+func TestAddImportWithoutImports(t *testing.T) {
+	got, changed := AddImport("part of 'dependency_injector.dart';\n\nfinal x = 1;\n", "package:a/a.dart")
+	want := "part of 'dependency_injector.dart';\n\nimport 'package:a/a.dart';\n\nfinal x = 1;\n"
+	if !changed || got != want {
+		t.Fatalf("AddImport() = %v\n%q", changed, got)
+	}
+}
+
+func TestAddListItem(t *testing.T) {
+	source := `part of 'dependency_injector.dart';
+
+final List<SingleChildWidget> _providers = [
+  Provider<Logger>(create: (_) => Logger()),
+
+  // Keep ] and ) in comments and 'strings ]' from confusing the parser
+  Provider<Dio>(create: (_) => Dio()),
+];
+`
+	item := `Provider<FirebaseAuth>(
+  create: (_) => FirebaseAuth.instance,
+),`
+
+	got, changed, err := AddListItem(source, "_providers", "Provider<FirebaseAuth>", item)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `part of 'dependency_injector.dart';
+
+final List<SingleChildWidget> _providers = [
+  Provider<Logger>(create: (_) => Logger()),
+
+  // Keep ] and ) in comments and 'strings ]' from confusing the parser
+  Provider<Dio>(create: (_) => Dio()),
+
+  Provider<FirebaseAuth>(
+    create: (_) => FirebaseAuth.instance,
+  ),
+];
+`
+	if !changed || got != want {
+		t.Fatalf("AddListItem() = %v\n%s", changed, got)
+	}
+
+	again, changed, err := AddListItem(got, "_providers", "Provider<FirebaseAuth>", item)
+	if err != nil || changed || again != got {
+		t.Fatalf("AddListItem() is not idempotent: %v %v\n%s", changed, err, again)
+	}
+}
+
+func TestAddListItemToEmptyList(t *testing.T) {
+	got, changed, err := AddListItem("final List<SingleChildWidget> _blocs = [];\n", "_blocs", "A", "A(),")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "final List<SingleChildWidget> _blocs = [\n  A(),\n];\n"
+	if !changed || got != want {
+		t.Fatalf("AddListItem() = %v\n%q", changed, got)
+	}
+
+	// The placeholder comment of a generated list stays on top
+	got, _, err = AddListItem("final List<BlocProvider> _blocs = [\n  // Add your BLoCs here\n];\n", "_blocs", "BlocProvider<B>", "BlocProvider<B>(),")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = "final List<BlocProvider> _blocs = [\n  // Add your BLoCs here\n  BlocProvider<B>(),\n];\n"
+	if got != want {
+		t.Fatalf("AddListItem() = %q", got)
+	}
+
+	if _, _, err := AddListItem("final x = 1;\n", "_blocs", "A", "A(),"); err == nil {
+		t.Fatal("expected an error for a missing list")
+	}
+}
+
+func TestAddRoute(t *testing.T) {
+	source := `class AppRouter extends RootStackRouter {
+  @override
+  List<AutoRoute> get routes => [
+        AutoRoute(page: HomeRoute.page, initial: true),
+      ];
+}
+`
+	got, changed, err := AddRoute(source, "UserListRoute.page", "AutoRoute(page: UserListRoute.page)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `class AppRouter extends RootStackRouter {
+  @override
+  List<AutoRoute> get routes => [
+        AutoRoute(page: HomeRoute.page, initial: true),
+        AutoRoute(page: UserListRoute.page),
+      ];
+}
+`
+	if !changed || got != want {
+		t.Fatalf("AddRoute() = %v\n%s", changed, got)
+	}
+
+	again, changed, err := AddRoute(got, "UserListRoute.page", "AutoRoute(page: UserListRoute.page)")
+	if err != nil || changed || again != got {
+		t.Fatalf("AddRoute() is not idempotent: %v %v\n%s", changed, err, again)
+	}
+
+	got, _, err = AddRoute("List<AutoRoute> get routes => [];\n", "A.page", "AutoRoute(page: A.page)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "List<AutoRoute> get routes => [\n  AutoRoute(page: A.page),\n];\n"; got != want {
+		t.Fatalf("AddRoute() = %q", got)
+	}
+
+	if _, _, err := AddRoute("class AppRouter {}\n", "A.page", "AutoRoute(page: A.page)"); err == nil {
+		t.Fatal("expected an error without a routes list")
+	}
+}
+
+func TestAddMainStatement(t *testing.T) {
+	got, changed, err := AddMainStatement(mainSource, "await FirebaseInitializer.initialize();")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, _, err = AddMainStatement(got, "await SupabaseConfig.initialize();")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `import 'package:flutter/material.dart';
+import 'app.dart';
+
+Future<void> main() async {
+  WidgetsFlutterBinding.ensureInitialized();
+  await FirebaseInitializer.initialize();
+  await SupabaseConfig.initialize();
+  runApp(const App());
+}
+`
+	if !changed || got != want {
+		t.Fatalf("AddMainStatement() = %v\n%s", changed, got)
+	}
+
+	again, changed, err := AddMainStatement(got, "await FirebaseInitializer.initialize();")
+	if err != nil || changed || again != got {
+		t.Fatalf("AddMainStatement() is not idempotent: %v %v\n%s", changed, err, again)
+	}
+}
+
+func TestAddMainStatementMultiline(t *testing.T) {
+	statement := "PlatformDispatcher.instance.onError = (error, stack) {\n  return true;\n};"
+	got, changed, err := AddMainStatement(mainSource, statement)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `import 'package:flutter/material.dart';
+import 'app.dart';
+
+Future<void> main() async {
+  WidgetsFlutterBinding.ensureInitialized();
+  PlatformDispatcher.instance.onError = (error, stack) {
+    return true;
+  };
+  runApp(const App());
+}
+`
+	if !changed || got != want {
+		t.Fatalf("AddMainStatement() = %v\n%s", changed, got)
+	}
+	if _, changed, _ := AddMainStatement(got, statement); changed {
+		t.Fatal("AddMainStatement() is not idempotent")
+	}
+}
+
+func TestAddMainStatementWithoutMain(t *testing.T) {
+	if _, _, err := AddMainStatement("void main() => runApp(const App());\n", "x();"); err == nil {
+		t.Fatal("expected an error for an expression-bodied main")
+	}
+}
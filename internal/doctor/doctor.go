@@ -0,0 +1,360 @@
+// Package doctor audits an existing Flutter project against the Pine layout
+// generated by fline create.
+package doctor
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"fline-cli/internal/config"
+	"fline-cli/internal/generator"
+	"fline-cli/internal/pubspec"
+	"fline-cli/internal/templates"
+	"fline-cli/internal/utils"
+)
+
+// Status is the outcome of a check
+type Status int
+
+const (
+	StatusPass Status = iota
+	StatusWarn
+	StatusFail
+)
+
+// Categories of checks, in report order
+const (
+	CategoryFolders      = "Folders"
+	CategoryCore         = "Core files"
+	CategoryDependencies = "Dependencies"
+	CategoryGenerated    = "Generated code"
+)
+
+// Categories lists the categories in report order
+var Categories = []string{CategoryFolders, CategoryCore, CategoryDependencies, CategoryGenerated}
+
+// Finding is the result of a single check
+type Finding struct {
+	Category   string
+	Title      string
+	Status     Status
+	Detail     string // What is wrong, empty when the check passed
+	Suggestion string // How to fix it by hand
+
+	fix func() error // Safe automatic fix, nil when there is none
+}
+
+// Fixable reports whether Fix can repair the finding
+func (f Finding) Fixable() bool {
+	return f.Status != StatusPass && f.fix != nil
+}
+
+// Report holds every finding of a run
+type Report struct {
+	Findings []Finding
+}
+
+// Count returns the number of findings with the given status
+func (r *Report) Count(status Status) int {
+	n := 0
+	for _, f := range r.Findings {
+		if f.Status == status {
+			n++
+		}
+	}
+	return n
+}
+
+// Score rates the project from 0 to 100: passed checks count fully,
+// warnings count half
+func (r *Report) Score() int {
+	if len(r.Findings) == 0 {
+		return 100
+	}
+	points := float64(r.Count(StatusPass)) + float64(r.Count(StatusWarn))/2
+	return int(math.Round(100 * points / float64(len(r.Findings))))
+}
+
+// Problems returns the findings that did not pass
+func (r *Report) Problems() []Finding {
+	var problems []Finding
+	for _, f := range r.Findings {
+		if f.Status != StatusPass {
+			problems = append(problems, f)
+		}
+	}
+	return problems
+}
+
+// Doctor checks the project rooted at a directory
+type Doctor struct {
+	root    string
+	writer  *utils.FileWriter
+	pubspec *pubspec.Pubspec
+	config  *config.ProjectConfig
+}
+
+// New loads the project rooted at root
+func New(root string) (*Doctor, error) {
+	spec, err := pubspec.Load(filepath.Join(root, "pubspec.yaml"))
+	if err != nil {
+		return nil, err
+	}
+
+	// Rebuild the configuration fline create would have used, so that
+	// dependencies and fixes match the project's backend
+	writer := utils.NewFileWriter(root)
+	cfg, err := generator.ConfigFromProject(writer, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Doctor{
+		root:    root,
+		writer:  writer,
+		pubspec: spec,
+		config:  cfg,
+	}, nil
+}
+
+// Run runs every check
+func (d *Doctor) Run() *Report {
+	report := &Report{}
+	report.Findings = append(report.Findings, d.checkFolders()...)
+	report.Findings = append(report.Findings, d.checkCoreFiles()...)
+	report.Findings = append(report.Findings, d.checkDependencies()...)
+	report.Findings = append(report.Findings, d.checkGenerated()...)
+	return report
+}
+
+// Fix applies the safe fixes of report and returns the repaired findings.
+// Safe fixes only create missing folders and files; nothing is overwritten.
+func (d *Doctor) Fix(report *Report) ([]Finding, error) {
+	var fixed []Finding
+	for _, f := range report.Findings {
+		if !f.Fixable() {
+			continue
+		}
+		if err := f.fix(); err != nil {
+			return fixed, fmt.Errorf("failed to fix %s: %w", f.Title, err)
+		}
+		fixed = append(fixed, f)
+	}
+	return fixed, nil
+}
+
+func (d *Doctor) checkFolders() []Finding {
+	var findings []Finding
+	for _, folder := range generator.ProjectFolders {
+		folder := folder
+		f := Finding{Category: CategoryFolders, Title: folder + "/"}
+		if !d.isDir(folder) {
+			f.Status = StatusFail
+			f.Detail = "missing"
+			f.Suggestion = "Create " + folder + "/"
+			f.fix = func() error { return d.writer.EnsureDir(folder) }
+		}
+		findings = append(findings, f)
+	}
+	return findings
+}
+
+// coreFiles are the files checked by checkCoreFiles, in report order
+var coreFiles = []string{
+	"lib/di/dependency_injector.dart",
+	"lib/di/blocs.dart",
+	"lib/di/mappers.dart",
+	"lib/di/providers.dart",
+	"lib/di/repositories.dart",
+	"lib/routers/app_router.dart",
+	"l10n.yaml",
+}
+
+func (d *Doctor) checkCoreFiles() []Finding {
+	var findings []Finding
+	for _, path := range coreFiles {
+		path := path
+		f := Finding{Category: CategoryCore, Title: path}
+		switch {
+		case !d.writer.PathExists(path):
+			f.Status = StatusFail
+			f.Detail = "missing"
+			f.Suggestion = fmt.Sprintf("Create %s from the %s template", path, generator.CoreFiles[path])
+			// The router imports every screen, so it can't be created blindly
+			if path != "lib/routers/app_router.dart" {
+				f.fix = func() error { return d.render(path) }
+			}
+		case path == "lib/routers/app_router.dart" && !d.contains(path, "@AutoRouterConfig"):
+			f.Status = StatusWarn
+			f.Detail = "no @AutoRouterConfig router found"
+			f.Suggestion = "Declare the routes in an auto_route @AutoRouterConfig class"
+		}
+		findings = append(findings, f)
+	}
+	return findings
+}
+
+func (d *Doctor) checkDependencies() []Finding {
+	deps, devDeps := templates.PubspecDependencies(d.config)
+
+	var findings []Finding
+	for _, dep := range deps {
+		findings = append(findings, d.checkDependency(pubspec.SectionDependencies, dep))
+	}
+	for _, dep := range devDeps {
+		findings = append(findings, d.checkDependency(pubspec.SectionDevDependencies, dep))
+	}
+	return findings
+}
+
+func (d *Doctor) checkDependency(section string, want templates.Dependency) Finding {
+	f := Finding{Category: CategoryDependencies, Title: want.Name}
+
+	got, ok := d.pubspec.Dependency(section, want.Name)
+	if !ok {
+		f.Status = StatusFail
+		f.Detail = "missing from " + section
+		f.Suggestion = addCommand(section, want)
+		return f
+	}
+
+	// SDK, path and git dependencies can't be compared with a constraint
+	if want.SDK != "" || got.Version == "" {
+		return f
+	}
+
+	required, ok := pubspec.MinVersion(want.Version)
+	if !ok {
+		return f
+	}
+	allowed, ok := pubspec.MinVersion(got.Version)
+	if !ok {
+		f.Status = StatusWarn
+		f.Detail = fmt.Sprintf("%q has no lower bound (required: %s)", got.Version, want.Version)
+		f.Suggestion = addCommand(section, want)
+		return f
+	}
+	if allowed.Compare(required) < 0 {
+		f.Status = StatusWarn
+		f.Detail = fmt.Sprintf("%s allows versions older than %s", got.Version, want.Version)
+		f.Suggestion = addCommand(section, want)
+	}
+
+	return f
+}
+
+// addCommand returns the command adding dep with its required constraint
+func addCommand(section string, dep templates.Dependency) string {
+	args := dep.Name
+	if dep.SDK != "" {
+		args = fmt.Sprintf("'%s:{\"sdk\":\"%s\"}'", dep.Name, dep.SDK)
+	} else if dep.Version != "" {
+		args = fmt.Sprintf("'%s:%s'", dep.Name, dep.Version)
+	}
+	if section == pubspec.SectionDevDependencies {
+		return "flutter pub add --dev " + args
+	}
+	return "flutter pub add " + args
+}
+
+// generatedPattern matches part directives and imports of code generated by
+// build_runner, e.g. part 'user.g.dart'; or
+// import 'package:my_app/routers/app_router.gr.dart';
+var generatedPattern = regexp.MustCompile(`(?m)^(?:part|import)\s+'([^']+\.(?:g|gr|freezed)\.dart)'`)
+
+// checkGenerated checks that every generated file referenced by the project
+// exists and is newer than the file referencing it
+func (d *Doctor) checkGenerated() []Finding {
+	var findings []Finding
+
+	lib := filepath.Join(d.root, "lib")
+	_ = filepath.Walk(lib, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || !isSourceFile(path) {
+			return nil
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil
+		}
+
+		for _, match := range generatedPattern.FindAllStringSubmatch(string(content), -1) {
+			part, ok := d.resolveImport(path, match[1])
+			if !ok {
+				continue
+			}
+			rel, _ := filepath.Rel(d.root, part)
+
+			f := Finding{Category: CategoryGenerated, Title: filepath.ToSlash(rel)}
+			partInfo, err := os.Stat(part)
+			switch {
+			case err != nil:
+				f.Status = StatusFail
+				f.Detail = "missing"
+			case partInfo.ModTime().Before(info.ModTime()):
+				f.Status = StatusWarn
+				f.Detail = "older than " + filepath.Base(path)
+			}
+			if f.Status != StatusPass {
+				f.Suggestion = "flutter pub run build_runner build --delete-conflicting-outputs"
+			}
+			findings = append(findings, f)
+		}
+		return nil
+	})
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].Title < findings[j].Title
+	})
+	return findings
+}
+
+// resolveImport returns the file referenced by uri from the Dart file at
+// path. Imports of other packages are not resolved.
+func (d *Doctor) resolveImport(path, uri string) (string, bool) {
+	if rest, ok := strings.CutPrefix(uri, "package:"); ok {
+		pkg, file, found := strings.Cut(rest, "/")
+		if !found || pkg != d.pubspec.Name() {
+			return "", false
+		}
+		return filepath.Join(d.root, "lib", filepath.FromSlash(file)), true
+	}
+	return filepath.Join(filepath.Dir(path), filepath.FromSlash(uri)), true
+}
+
+// isSourceFile reports whether path is hand-written Dart code
+func isSourceFile(path string) bool {
+	if !strings.HasSuffix(path, ".dart") {
+		return false
+	}
+	for _, suffix := range []string{".g.dart", ".gr.dart", ".freezed.dart"} {
+		if strings.HasSuffix(path, suffix) {
+			return false
+		}
+	}
+	return true
+}
+
+// render creates a missing core file from its template
+func (d *Doctor) render(path string) error {
+	content, err := templates.NewLoader(d.root).Render(generator.CoreFiles[path], templates.NewProjectData(d.config))
+	if err != nil {
+		return err
+	}
+	return d.writer.WriteFile(path, content)
+}
+
+func (d *Doctor) isDir(path string) bool {
+	info, err := os.Stat(d.writer.GetFullPath(path))
+	return err == nil && info.IsDir()
+}
+
+func (d *Doctor) contains(path, text string) bool {
+	content, err := d.writer.ReadFile(path)
+	return err == nil && strings.Contains(content, text)
+}
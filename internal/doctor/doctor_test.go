@@ -0,0 +1,189 @@
+package doctor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"fline-cli/internal/config"
+	"fline-cli/internal/fluttertest"
+	"fline-cli/internal/generator"
+)
+
+// generateProject creates a project with fline create and a fake toolchain
+func generateProject(t *testing.T) string {
+	t.Helper()
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	target := t.TempDir()
+	cfg := config.DefaultProjectConfig()
+	cfg.ProjectName = "demo_app"
+	cfg.TargetDirectory = target
+
+	gen := generator.NewProjectGenerator(cfg)
+	gen.SetFlutterFactory(fluttertest.New().Factory())
+	if err := gen.Generate(context.Background()); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	return filepath.Join(target, "demo_app")
+}
+
+func findings(report *Report, category string) map[string]Finding {
+	found := map[string]Finding{}
+	for _, f := range report.Findings {
+		if f.Category == category {
+			found[f.Title] = f
+		}
+	}
+	return found
+}
+
+func TestDoctorGeneratedProject(t *testing.T) {
+	root := generateProject(t)
+
+	doc, err := New(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	report := doc.Run()
+
+	for _, f := range report.Findings {
+		if f.Category != CategoryGenerated && f.Status != StatusPass {
+			t.Errorf("%s %s: status %d (%s), want pass", f.Category, f.Title, f.Status, f.Detail)
+		}
+	}
+
+	// The fake toolchain doesn't run build_runner
+	router := findings(report, CategoryGenerated)["lib/routers/app_router.gr.dart"]
+	if router.Status != StatusFail || router.Fixable() {
+		t.Errorf("app_router.gr.dart finding = %+v, want unfixable failure", router)
+	}
+}
+
+func TestDoctorFixCreatesMissingFiles(t *testing.T) {
+	root := generateProject(t)
+	for _, path := range []string{"lib/mappers", "lib/di/blocs.dart", "l10n.yaml", "lib/routers/app_router.dart"} {
+		if err := os.RemoveAll(filepath.Join(root, path)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	doc, err := New(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	report := doc.Run()
+	before := report.Score()
+
+	fixed, err := doc.Fix(report)
+	if err != nil {
+		t.Fatalf("Fix() error = %v", err)
+	}
+	if len(fixed) != 3 {
+		t.Errorf("fixed %d findings, want 3 (the router is not fixable)", len(fixed))
+	}
+
+	for _, path := range []string{"lib/mappers", "lib/di/blocs.dart", "l10n.yaml"} {
+		if _, err := os.Stat(filepath.Join(root, path)); err != nil {
+			t.Errorf("%s not restored: %v", path, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(root, "lib/routers/app_router.dart")); !os.IsNotExist(err) {
+		t.Errorf("router was created by Fix")
+	}
+
+	if after := doc.Run().Score(); after <= before {
+		t.Errorf("score after fix = %d, want more than %d", after, before)
+	}
+}
+
+func TestDoctorDependencies(t *testing.T) {
+	root := t.TempDir()
+	spec := `name: legacy_app
+dependencies:
+  flutter:
+    sdk: flutter
+  # Too old for the Pine templates
+  flutter_bloc: ^8.0.0
+  dio: any
+  pine: ^1.2.0
+dev_dependencies:
+  build_runner: ^2.11.1
+`
+	if err := os.WriteFile(filepath.Join(root, "pubspec.yaml"), []byte(spec), 0644); err != nil {
+		t.Fatal(err)
+	}
+	gradle := filepath.Join(root, "android", "app", "build.gradle")
+	if err := os.MkdirAll(filepath.Dir(gradle), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(gradle, []byte("defaultConfig {\n    applicationId \"com.legacy.legacy_app\"\n}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err := New(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	deps := findings(doc.Run(), CategoryDependencies)
+
+	tests := []struct {
+		name       string
+		status     Status
+		suggestion string
+	}{
+		{"flutter", StatusPass, ""},
+		{"pine", StatusPass, ""},
+		{"build_runner", StatusPass, ""},
+		{"flutter_bloc", StatusWarn, "flutter pub add 'flutter_bloc:^9.1.1'"},
+		{"dio", StatusWarn, "flutter pub add 'dio:^5.9.2'"},
+		{"logger", StatusFail, "flutter pub add 'logger:^2.6.2'"},
+		{"flutter_localizations", StatusFail, `flutter pub add 'flutter_localizations:{"sdk":"flutter"}'`},
+		{"mockito", StatusFail, "flutter pub add --dev 'mockito:^5.6.3'"},
+	}
+	for _, tt := range tests {
+		f, ok := deps[tt.name]
+		if !ok {
+			t.Errorf("no finding for %s", tt.name)
+			continue
+		}
+		if f.Status != tt.status || f.Suggestion != tt.suggestion {
+			t.Errorf("%s: status %d, suggestion %q; want %d, %q", tt.name, f.Status, f.Suggestion, tt.status, tt.suggestion)
+		}
+	}
+}
+
+func TestDoctorStaleGeneratedCode(t *testing.T) {
+	root := t.TempDir()
+	files := map[string]string{
+		"pubspec.yaml":                 "name: app\n",
+		"android/app/build.gradle.kts": "android {\n    namespace = \"com.example.app\"\n}\n",
+		"lib/model/user.dart":          "part 'user.g.dart';\n",
+		"lib/model/user.g.dart":        "// generated\n",
+	}
+	for path, content := range files {
+		full := filepath.Join(root, path)
+		if err := os.MkdirAll(filepath.Dir(full), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(full, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	old := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(filepath.Join(root, "lib/model/user.g.dart"), old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err := New(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := findings(doc.Run(), CategoryGenerated)["lib/model/user.g.dart"]
+	if f.Status != StatusWarn {
+		t.Errorf("user.g.dart finding = %+v, want stale warning", f)
+	}
+}
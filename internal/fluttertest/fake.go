@@ -0,0 +1,195 @@
+// Package fluttertest provides a recording fake of the Flutter toolchain so
+// that generators can be exercised without a Flutter SDK.
+package fluttertest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"fline-cli/internal/utils"
+)
+
+// Call is a recorded toolchain invocation
+type Call struct {
+	Dir  string   // Working directory
+	Args []string // Arguments as they would be passed to flutter
+}
+
+// String returns the call as a command line, e.g. "flutter pub get"
+func (c Call) String() string {
+	return "flutter " + strings.Join(c.Args, " ")
+}
+
+// Fake records every toolchain call. Create writes a minimal project
+// skeleton; every other command only records its arguments. Every command
+// echoes its command line to the output and fails once ctx is done.
+type Fake struct {
+	mu    sync.Mutex
+	calls []Call
+
+	// Failures maps a command line without the "flutter " prefix
+	// (e.g. "pub get") to the error it returns
+	Failures map[string]error
+}
+
+// New creates a fake toolchain
+func New() *Fake {
+	return &Fake{Failures: map[string]error{}}
+}
+
+// Factory returns a FlutterFactory creating fakes that record into f
+func (f *Fake) Factory() utils.FlutterFactory {
+	return func(workingDir string, output io.Writer) utils.Flutter {
+		return &cli{fake: f, dir: workingDir, output: output}
+	}
+}
+
+// FailOn makes the command (e.g. "pub get") fail with err
+func (f *Fake) FailOn(command string, err error) {
+	f.Failures[command] = err
+}
+
+// Calls returns the recorded calls
+func (f *Fake) Calls() []Call {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]Call(nil), f.calls...)
+}
+
+// Commands returns the recorded calls as command lines
+func (f *Fake) Commands() []string {
+	var commands []string
+	for _, c := range f.Calls() {
+		commands = append(commands, c.String())
+	}
+	return commands
+}
+
+type cli struct {
+	fake   *Fake
+	dir    string
+	output io.Writer
+}
+
+func (c *cli) record(ctx context.Context, args ...string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	c.fake.mu.Lock()
+	defer c.fake.mu.Unlock()
+
+	call := Call{Dir: c.dir, Args: args}
+	c.fake.calls = append(c.fake.calls, call)
+	if c.output != nil {
+		fmt.Fprintln(c.output, call.String())
+	}
+	return c.fake.Failures[strings.Join(args, " ")]
+}
+
+func (c *cli) Create(ctx context.Context, projectName, org string, force bool) error {
+	args := []string{"create", "--org", org, "--project-name", projectName, "--no-pub"}
+	if force {
+		args = append(args, "--overwrite")
+	}
+	args = append(args, projectName)
+
+	if err := c.record(ctx, args...); err != nil {
+		return err
+	}
+
+	return writeSkeleton(filepath.Join(c.dir, projectName), projectName, org)
+}
+
+func (c *cli) PubGet(ctx context.Context, offline bool) error {
+	if offline {
+		return c.record(ctx, "pub", "get", "--offline")
+	}
+	return c.record(ctx, "pub", "get")
+}
+
+func (c *cli) PubAdd(ctx context.Context, packages ...string) error {
+	return c.record(ctx, append([]string{"pub", "add"}, packages...)...)
+}
+
+func (c *cli) PubAddDev(ctx context.Context, packages ...string) error {
+	return c.record(ctx, append([]string{"pub", "add", "--dev"}, packages...)...)
+}
+
+func (c *cli) GenL10n(ctx context.Context) error {
+	return c.record(ctx, "gen-l10n")
+}
+
+func (c *cli) BuildRunnerBuild(ctx context.Context) error {
+	return c.record(ctx, "pub", "run", "build_runner", "build", "--delete-conflicting-outputs")
+}
+
+func (c *cli) DartRun(ctx context.Context, args ...string) error {
+	return c.record(ctx, append([]string{"pub", "run"}, args...)...)
+}
+
+// writeSkeleton writes the files of a fresh flutter create that the
+// generators read or replace
+func writeSkeleton(dir, projectName, org string) error {
+	files := map[string]string{
+		"pubspec.yaml": fmt.Sprintf(`name: %s
+description: "A new Flutter project."
+publish_to: 'none'
+version: 1.0.0+1
+
+environment:
+  sdk: ^3.8.0
+
+dependencies:
+  flutter:
+    sdk: flutter
+
+  cupertino_icons: ^1.0.8
+
+dev_dependencies:
+  flutter_test:
+    sdk: flutter
+
+  flutter_lints: ^5.0.0
+
+flutter:
+  uses-material-design: true
+`, projectName),
+		".gitignore": `# Miscellaneous
+*.log
+.DS_Store
+
+# Flutter/Dart/Pub related
+.dart_tool/
+.pub-cache/
+build/
+`,
+		"android/app/build.gradle.kts": fmt.Sprintf(`android {
+    namespace = "%[1]s.%[2]s"
+
+    defaultConfig {
+        applicationId = "%[1]s.%[2]s"
+    }
+}
+`, org, projectName),
+		"lib/main.dart": `import 'package:flutter/material.dart';
+
+void main() {
+  runApp(const MaterialApp(home: Placeholder()));
+}
+`,
+	}
+
+	writer := utils.NewFileWriter(dir)
+	for path, content := range files {
+		if err := writer.WriteFile(path, content); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
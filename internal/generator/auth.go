@@ -0,0 +1,138 @@
+package generator
+
+import (
+	"strings"
+
+	"fline-cli/internal/config"
+	"fline-cli/internal/templates"
+	"fline-cli/internal/ui"
+	"fline-cli/internal/utils"
+)
+
+// authFiles maps the files of the authentication flow to their templates
+var authFiles = []struct{ Path, Template string }{
+	{"lib/repositories/auth_repository.dart", "auth/auth_repository.dart"},
+	{"lib/state_management/bloc/auth/auth_bloc.dart", "auth/auth_bloc.dart"},
+	{"lib/state_management/bloc/auth/auth_event.dart", "auth/auth_event.dart"},
+	{"lib/state_management/bloc/auth/auth_state.dart", "auth/auth_state.dart"},
+	{"lib/utils/validators.dart", "auth/validators.dart"},
+	{"lib/utils/auth_error_messages.dart", "auth/auth_error_messages.dart"},
+	{"lib/routers/auth_guard.dart", "auth/auth_guard.dart"},
+}
+
+// socialSignInChecklist lists the platform setup of the social providers
+const socialSignInChecklist = "SOCIAL_SIGN_IN.md"
+
+// tokenStoreFile keeps the session tokens when no auth backend is selected
+const tokenStoreFile = "lib/repositories/token_store.dart"
+
+// authServices maps each auth backend to the DI type of its auth service
+var authServices = map[string]string{
+	config.AuthFirebase: "AuthService",
+	config.AuthSupabase: "SupabaseAuthService",
+	config.AuthRest:     "AuthService",
+}
+
+// AuthGenerator generates the authentication flow behind the login, sign
+// up and password reset screens: an AuthRepository over the auth service
+// of the backend, the AuthBloc, the AuthGuard of the router and the
+// repository registration in the DI
+type AuthGenerator struct {
+	config *config.ProjectConfig
+	writer *utils.FileWriter
+	loader *templates.Loader
+	logger *ui.Logger
+}
+
+// NewAuthGenerator creates a new auth generator
+func NewAuthGenerator(cfg *config.ProjectConfig, writer *utils.FileWriter) *AuthGenerator {
+	return &AuthGenerator{
+		config: cfg,
+		writer: writer,
+		loader: templates.NewLoader(writer.BaseDir()),
+		logger: ui.NewLogger("auth"),
+	}
+}
+
+// Generate writes the authentication flow. The auth service of the backend
+// is expected to be registered already.
+func (g *AuthGenerator) Generate() error {
+	data := templates.NewProjectData(g.config)
+	for _, file := range authFiles {
+		content, err := g.loader.Render(file.Template, data)
+		if err != nil {
+			return err
+		}
+		if err := g.writer.WriteFile(file.Path, content); err != nil {
+			return err
+		}
+	}
+
+	if g.config.AuthBackend() == "" {
+		if err := g.generateTokenStore(); err != nil {
+			return err
+		}
+	}
+
+	if err := registerDI(g.writer, g.logger, repositoriesList,
+		[]string{packageImport(g.config.ProjectName, "lib/repositories/auth_repository.dart")},
+		[]diProvider{{Type: "AuthRepository", Code: g.repositoryProvider()}}); err != nil {
+		return err
+	}
+
+	if len(g.config.SocialSignIn) > 0 {
+		content, err := g.loader.Render("auth/social_sign_in.md", data)
+		if err != nil {
+			return err
+		}
+		if err := g.writer.WriteFile(socialSignInChecklist, content); err != nil {
+			return err
+		}
+		g.logger.Info("Social sign-in needs platform setup, see " + socialSignInChecklist)
+	}
+
+	if g.config.AuthBackend() == "" {
+		g.logger.Warning("No auth module selected: connect lib/repositories/auth_repository.dart to your backend")
+	}
+	return nil
+}
+
+// generateTokenStore writes the TokenStore the AuthRepository of projects
+// without an auth backend restores the session from
+func (g *AuthGenerator) generateTokenStore() error {
+	content, err := g.loader.Render("auth/token_store.dart", templates.NewProjectData(g.config))
+	if err != nil {
+		return err
+	}
+	if err := g.writer.WriteFile(tokenStoreFile, content); err != nil {
+		return err
+	}
+
+	return registerProviders(g.writer, g.logger,
+		[]string{packageImport(g.config.ProjectName, tokenStoreFile)},
+		[]diProvider{{Type: "TokenStore", Code: `Provider<TokenStore>(
+  create: (context) =>
+      TokenStore(storage: context.read<FlutterSecureStorage>()),
+),`}})
+}
+
+// repositoryProvider returns the DI entry of the AuthRepository. The
+// session of the REST API and of projects without an auth backend is kept
+// by TokenStore.
+func (g *AuthGenerator) repositoryProvider() string {
+	backend := g.config.AuthBackend()
+	var args []string
+	if service, ok := authServices[backend]; ok {
+		args = append(args, "service: context.read<"+service+">(),")
+	}
+	if backend == config.AuthRest || backend == "" {
+		args = append(args, "tokens: context.read<TokenStore>(),")
+	}
+	args = append(args, "logger: context.read<Logger>(),")
+
+	return `RepositoryProvider<AuthRepository>(
+  create: (context) => AuthRepository(
+    ` + strings.Join(args, "\n    ") + `
+  ),
+),`
+}
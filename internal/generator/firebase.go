@@ -2,156 +2,153 @@ package generator
 
 import (
 	"fmt"
+	"os"
+
 	"fline-cli/internal/config"
+	"fline-cli/internal/templates"
 	"fline-cli/internal/ui"
 	"fline-cli/internal/utils"
 )
 
 // FirebaseGenerator handles Firebase integration
 type FirebaseGenerator struct {
-	config  *config.ProjectConfig
-	writer  *utils.FileWriter
-	flutter *utils.FlutterCLI
-	logger  *ui.Logger
+	config *config.ProjectConfig
+	writer *utils.FileWriter
+	loader *templates.Loader
+	logger *ui.Logger
 }
 
 // NewFirebaseGenerator creates a new Firebase generator
-func NewFirebaseGenerator(cfg *config.ProjectConfig, writer *utils.FileWriter, flutter *utils.FlutterCLI) *FirebaseGenerator {
+func NewFirebaseGenerator(cfg *config.ProjectConfig, writer *utils.FileWriter) *FirebaseGenerator {
 	return &FirebaseGenerator{
-		config:  cfg,
-		writer:  writer,
-		flutter: flutter,
-		logger:  ui.NewLogger("firebase"),
+		config: cfg,
+		writer: writer,
+		loader: templates.NewLoader(writer.BaseDir()),
+		logger: ui.NewLogger("firebase"),
 	}
 }
 
-// Generate sets up Firebase
+// Generate sets up Firebase with the modules selected in the config. The
+// packages are expected to be in the pubspec already.
 func (g *FirebaseGenerator) Generate() error {
 	g.logger.Info("Setting up Firebase integration...")
 
-	// Add Firebase packages
-	packages := []string{
-		"firebase_core",
-		"firebase_auth",
-		"cloud_firestore",
-	}
-
-	if g.config.EnableNotifications {
-		packages = append(packages, "firebase_messaging")
-	}
-
-	// Note: packages are already in pubspec, so we don't need to add them again
-
 	// Generate Firebase initialization
 	if err := g.generateFirebaseInit(); err != nil {
 		return err
 	}
 
-	// Generate auth service if needed
-	if err := g.generateAuthService(); err != nil {
-		return err
+	modules := g.config.Firebase
+	steps := []struct {
+		enabled  bool
+		generate func() error
+	}{
+		{modules.EnableAuth, g.generateAuthService},
+		{modules.EnableFirestore, g.generateFirestore},
+		{modules.EnableStorage, g.generateStorageService},
+		{modules.EnableMessaging, g.GenerateNotifications},
+		{modules.EnableAnalytics, g.generateAnalyticsService},
+		{modules.EnableCrashlytics, g.generateCrashlytics},
+	}
+	for _, step := range steps {
+		if !step.enabled {
+			continue
+		}
+		if err := step.generate(); err != nil {
+			return err
+		}
 	}
 
 	g.logger.Success("Firebase integration configured")
 	g.logger.Info("Don't forget to:")
 	g.logger.Info("1. Create a Firebase project at https://console.firebase.google.com")
-	g.logger.Info("2. Run: flutterfire configure")
+	g.logger.Info("2. Run: flutterfire configure (replaces lib/firebase_options.dart)")
 	g.logger.Info("3. Follow the setup instructions")
 
 	return nil
 }
 
-func (g *FirebaseGenerator) generateFirebaseInit() error {
-	content := `import 'package:firebase_core/firebase_core.dart';
-import 'firebase_options.dart';
-
-class FirebaseInitializer {
-  static Future<void> initialize() async {
-    await Firebase.initializeApp(
-      options: DefaultFirebaseOptions.currentPlatform,
-    );
-  }
+// GenerateNotifications sets up Firebase Cloud Messaging on top of an
+// existing Firebase integration
+func (g *FirebaseGenerator) GenerateNotifications() error {
+	cfg := *g.config
+	cfg.EnableNotifications = true
+	cfg.NotificationService = config.NotificationsFCM
+	return NewNotificationGenerator(&cfg, g.writer).Generate()
 }
-`
 
-	return g.writer.WriteFile("lib/utils/firebase_initializer.dart", content)
+func (g *FirebaseGenerator) generateFirebaseInit() error {
+	if err := g.render("firebase/firebase_initializer.dart", "lib/utils/firebase_initializer.dart"); err != nil {
+		return err
+	}
+	if err := g.generateFirebaseOptions(); err != nil {
+		return err
+	}
+
+	return wireMain(g.writer, g.logger,
+		[]string{packageImport(g.config.ProjectName, "lib/utils/firebase_initializer.dart")},
+		[]string{"await FirebaseInitializer.initialize();"})
 }
 
-func (g *FirebaseGenerator) generateAuthService() error {
-	content := fmt.Sprintf(`import 'package:firebase_auth/firebase_auth.dart';
-import 'package:logger/logger.dart';
-
-class AuthService {
-  final FirebaseAuth _auth;
-  final Logger _logger;
-
-  AuthService({
-    required FirebaseAuth auth,
-    required Logger logger,
-  })  : _auth = auth,
-        _logger = logger;
-
-  // Get current user
-  User? get currentUser => _auth.currentUser;
-
-  // Auth state changes
-  Stream<User?> get authStateChanges => _auth.authStateChanges();
-
-  // Sign in with email and password
-  Future<UserCredential> signInWithEmailAndPassword({
-    required String email,
-    required String password,
-  }) async {
-    try {
-      return await _auth.signInWithEmailAndPassword(
-        email: email,
-        password: password,
-      );
-    } catch (e) {
-      _logger.e('Sign in error', error: e);
-      rethrow;
-    }
-  }
-
-  // Register with email and password
-  Future<UserCredential> registerWithEmailAndPassword({
-    required String email,
-    required String password,
-  }) async {
-    try {
-      return await _auth.createUserWithEmailAndPassword(
-        email: email,
-        password: password,
-      );
-    } catch (e) {
-      _logger.e('Registration error', error: e);
-      rethrow;
-    }
-  }
-
-  // Sign out
-  Future<void> signOut() async {
-    try {
-      await _auth.signOut();
-    } catch (e) {
-      _logger.e('Sign out error', error: e);
-      rethrow;
-    }
-  }
-
-  // Reset password
-  Future<void> resetPassword(String email) async {
-    try {
-      await _auth.sendPasswordResetEmail(email: email);
-    } catch (e) {
-      _logger.e('Password reset error', error: e);
-      rethrow;
-    }
-  }
+// generateFirebaseOptions writes lib/firebase_options.dart, the file
+// flutterfire configure would generate. Platforms with a native
+// configuration file get real options, the others throw until flutterfire
+// configure is run.
+func (g *FirebaseGenerator) generateFirebaseOptions() error {
+	copies := []struct{ source, path string }{
+		{g.config.Firebase.AndroidConfig, androidConfigFile},
+		{g.config.Firebase.IOSConfig, iosConfigFile},
+	}
+	for _, c := range copies {
+		if c.source == "" {
+			continue
+		}
+		content, err := os.ReadFile(c.source)
+		if err != nil {
+			return fmt.Errorf("failed to read Firebase configuration: %w", err)
+		}
+		if err := g.writer.WriteFile(c.path, string(content)); err != nil {
+			return err
+		}
+	}
+
+	data := templates.NewProjectData(g.config)
+	if g.writer.PathExists(androidConfigFile) {
+		content, err := g.writer.ReadFile(androidConfigFile)
+		if err != nil {
+			return err
+		}
+		packageName := g.config.OrganizationName + "." + g.config.ProjectName
+		if data.FirebaseAndroid, err = ParseGoogleServices([]byte(content), packageName); err != nil {
+			return err
+		}
+	}
+	if g.writer.PathExists(iosConfigFile) {
+		content, err := g.writer.ReadFile(iosConfigFile)
+		if err != nil {
+			return err
+		}
+		if data.FirebaseIOS, err = ParseGoogleServiceInfo([]byte(content)); err != nil {
+			return err
+		}
+	}
+
+	content, err := g.loader.Render("firebase/firebase_options.dart", data)
+	if err != nil {
+		return err
+	}
+	if err := g.writer.WriteFile("lib/firebase_options.dart", content); err != nil {
+		return err
+	}
+
+	if data.FirebaseAndroid == nil && data.FirebaseIOS == nil {
+		g.logger.Warning("No google-services.json or GoogleService-Info.plist found: Firebase options are a stub")
+	}
+	return nil
 }
-`)
 
-	if err := g.writer.WriteFile("lib/network/service/auth_service.dart", content); err != nil {
+func (g *FirebaseGenerator) generateAuthService() error {
+	if err := g.render("firebase/auth_service.dart", "lib/network/service/auth_service.dart"); err != nil {
 		return err
 	}
 
@@ -159,20 +156,90 @@ class AuthService {
 	return g.addAuthProvider()
 }
 
-func (g *FirebaseGenerator) addAuthProvider() error {
-	// This is a simplified version - in production you'd want to properly insert into the existing file
-	content := `
-  // Firebase Auth
-  Provider<FirebaseAuth>(create: (_) => FirebaseAuth.instance),
-  Provider<AuthService>(
-    create: (context) => AuthService(
-      auth: context.read<FirebaseAuth>(),
-      logger: context.read<Logger>(),
-    ),
+func (g *FirebaseGenerator) generateFirestore() error {
+	return registerProviders(g.writer, g.logger,
+		[]string{"package:cloud_firestore/cloud_firestore.dart"},
+		[]diProvider{
+			{Type: "FirebaseFirestore", Code: `Provider<FirebaseFirestore>(create: (_) => FirebaseFirestore.instance),`},
+		})
+}
+
+func (g *FirebaseGenerator) generateStorageService() error {
+	if err := g.render("firebase/storage_service.dart", "lib/network/service/storage_service.dart"); err != nil {
+		return err
+	}
+
+	return registerProviders(g.writer, g.logger,
+		[]string{
+			"package:firebase_storage/firebase_storage.dart",
+			packageImport(g.config.ProjectName, "lib/network/service/storage_service.dart"),
+		},
+		[]diProvider{
+			{Type: "FirebaseStorage", Code: `Provider<FirebaseStorage>(create: (_) => FirebaseStorage.instance),`},
+			{Type: "StorageService", Code: `Provider<StorageService>(
+  create: (context) => StorageService(
+    storage: context.read<FirebaseStorage>(),
+    logger: context.read<Logger>(),
   ),
-`
-	g.logger.Warning("Remember to add Firebase providers to lib/di/providers.dart:")
-	g.logger.Info(content)
+),`},
+		})
+}
 
-	return nil
+func (g *FirebaseGenerator) generateAnalyticsService() error {
+	if err := g.render("firebase/analytics_service.dart", "lib/network/service/analytics_service.dart"); err != nil {
+		return err
+	}
+
+	return registerProviders(g.writer, g.logger,
+		[]string{
+			"package:firebase_analytics/firebase_analytics.dart",
+			packageImport(g.config.ProjectName, "lib/network/service/analytics_service.dart"),
+		},
+		[]diProvider{
+			{Type: "FirebaseAnalytics", Code: `Provider<FirebaseAnalytics>(create: (_) => FirebaseAnalytics.instance),`},
+			{Type: "AnalyticsService", Code: `Provider<AnalyticsService>(
+  create: (context) => AnalyticsService(
+    analytics: context.read<FirebaseAnalytics>(),
+    logger: context.read<Logger>(),
+  ),
+),`},
+		})
+}
+
+// generateCrashlytics reports uncaught Flutter and platform errors
+func (g *FirebaseGenerator) generateCrashlytics() error {
+	return wireMain(g.writer, g.logger,
+		[]string{"package:firebase_crashlytics/firebase_crashlytics.dart"},
+		[]string{
+			"FlutterError.onError = FirebaseCrashlytics.instance.recordFlutterFatalError;",
+			`WidgetsBinding.instance.platformDispatcher.onError = (error, stack) {
+  FirebaseCrashlytics.instance.recordError(error, stack, fatal: true);
+  return true;
+};`,
+		})
+}
+
+func (g *FirebaseGenerator) render(name, path string) error {
+	content, err := g.loader.Render(name, templates.NewProjectData(g.config))
+	if err != nil {
+		return err
+	}
+	return g.writer.WriteFile(path, content)
+}
+
+func (g *FirebaseGenerator) addAuthProvider() error {
+	return registerProviders(g.writer, g.logger,
+		[]string{
+			"package:firebase_auth/firebase_auth.dart",
+			packageImport(g.config.ProjectName, "lib/network/service/auth_service.dart"),
+		},
+		[]diProvider{
+			{Type: "FirebaseAuth", Code: `Provider<FirebaseAuth>(create: (_) => FirebaseAuth.instance),`},
+			{Type: "AuthService", Code: `Provider<AuthService>(
+  create: (context) => AuthService(
+    auth: context.read<FirebaseAuth>(),
+    logger: context.read<Logger>(),
+  ),
+),`},
+		})
 }
@@ -0,0 +1,177 @@
+package generator
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"fline-cli/internal/templates"
+)
+
+// Native Firebase configuration files, where the Firebase console and
+// flutterfire configure put them
+const (
+	androidConfigFile = "android/app/google-services.json"
+	iosConfigFile     = "ios/Runner/GoogleService-Info.plist"
+)
+
+// googleServices is the part of google-services.json that FirebaseOptions
+// needs
+type googleServices struct {
+	ProjectInfo struct {
+		ProjectNumber string `json:"project_number"`
+		ProjectID     string `json:"project_id"`
+		StorageBucket string `json:"storage_bucket"`
+	} `json:"project_info"`
+	Client []struct {
+		ClientInfo struct {
+			MobileSDKAppID    string `json:"mobilesdk_app_id"`
+			AndroidClientInfo struct {
+				PackageName string `json:"package_name"`
+			} `json:"android_client_info"`
+		} `json:"client_info"`
+		APIKey []struct {
+			CurrentKey string `json:"current_key"`
+		} `json:"api_key"`
+	} `json:"client"`
+}
+
+// ParseGoogleServices reads the Android options of the app packageName from
+// a google-services.json file. The first client is used when none matches.
+func ParseGoogleServices(content []byte, packageName string) (*templates.FirebasePlatformOptions, error) {
+	var services googleServices
+	if err := json.Unmarshal(content, &services); err != nil {
+		return nil, fmt.Errorf("invalid google-services.json: %w", err)
+	}
+	if len(services.Client) == 0 {
+		return nil, fmt.Errorf("google-services.json has no Android app")
+	}
+
+	client := services.Client[0]
+	for _, c := range services.Client {
+		if c.ClientInfo.AndroidClientInfo.PackageName == packageName {
+			client = c
+			break
+		}
+	}
+
+	options := &templates.FirebasePlatformOptions{
+		AppID:             client.ClientInfo.MobileSDKAppID,
+		MessagingSenderID: services.ProjectInfo.ProjectNumber,
+		ProjectID:         services.ProjectInfo.ProjectID,
+		StorageBucket:     services.ProjectInfo.StorageBucket,
+	}
+	if len(client.APIKey) > 0 {
+		options.APIKey = client.APIKey[0].CurrentKey
+	}
+	if err := checkFirebaseOptions(options); err != nil {
+		return nil, fmt.Errorf("google-services.json: %w", err)
+	}
+	return options, nil
+}
+
+// ParseGoogleServiceInfo reads the iOS options from a
+// GoogleService-Info.plist file
+func ParseGoogleServiceInfo(content []byte) (*templates.FirebasePlatformOptions, error) {
+	values, err := plistStrings(content)
+	if err != nil {
+		return nil, fmt.Errorf("invalid GoogleService-Info.plist: %w", err)
+	}
+
+	options := &templates.FirebasePlatformOptions{
+		APIKey:            values["API_KEY"],
+		AppID:             values["GOOGLE_APP_ID"],
+		MessagingSenderID: values["GCM_SENDER_ID"],
+		ProjectID:         values["PROJECT_ID"],
+		StorageBucket:     values["STORAGE_BUCKET"],
+		IOSClientID:       values["CLIENT_ID"],
+		IOSBundleID:       values["BUNDLE_ID"],
+	}
+	if err := checkFirebaseOptions(options); err != nil {
+		return nil, fmt.Errorf("GoogleService-Info.plist: %w", err)
+	}
+	return options, nil
+}
+
+// checkFirebaseOptions rejects options that Firebase.initializeApp refuses
+// or that would break the Dart string literals they are written to
+func checkFirebaseOptions(options *templates.FirebasePlatformOptions) error {
+	fields := []struct {
+		name     string
+		value    string
+		required bool
+	}{
+		{"API key", options.APIKey, true},
+		{"app ID", options.AppID, true},
+		{"sender ID", options.MessagingSenderID, true},
+		{"project ID", options.ProjectID, true},
+		{"storage bucket", options.StorageBucket, false},
+		{"iOS client ID", options.IOSClientID, false},
+		{"iOS bundle ID", options.IOSBundleID, false},
+	}
+	for _, f := range fields {
+		if f.value == "" {
+			if f.required {
+				return fmt.Errorf("missing %s", f.name)
+			}
+			continue
+		}
+		if strings.ContainsAny(f.value, "'\\$\r\n") {
+			return fmt.Errorf("invalid %s %q", f.name, f.value)
+		}
+	}
+	return nil
+}
+
+// plistStrings returns the string values of the top-level dictionary of an
+// XML property list
+func plistStrings(content []byte) (map[string]string, error) {
+	decoder := xml.NewDecoder(strings.NewReader(string(content)))
+	values := map[string]string{}
+
+	depth := 0 // Nesting below the top-level dict
+	key := ""
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch {
+			case t.Name.Local == "dict":
+				depth++
+			case depth == 1 && t.Name.Local == "key":
+				var text string
+				if err := decoder.DecodeElement(&text, &t); err != nil {
+					return nil, err
+				}
+				key = text
+			case depth == 1 && t.Name.Local == "string" && key != "":
+				var text string
+				if err := decoder.DecodeElement(&text, &t); err != nil {
+					return nil, err
+				}
+				values[key] = strings.TrimSpace(text)
+				key = ""
+			case depth == 1:
+				key = "" // Non-string value
+			}
+		case xml.EndElement:
+			if t.Name.Local == "dict" {
+				depth--
+			}
+		}
+	}
+
+	if len(values) == 0 {
+		return nil, fmt.Errorf("no string values found")
+	}
+	return values, nil
+}
@@ -0,0 +1,121 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+const googleServicesJSON = `{
+  "project_info": {
+    "project_number": "42",
+    "project_id": "demo-project",
+    "storage_bucket": "demo-project.appspot.com"
+  },
+  "client": [
+    {
+      "client_info": {
+        "mobilesdk_app_id": "1:42:android:other",
+        "android_client_info": {"package_name": "com.example.other"}
+      },
+      "api_key": [{"current_key": "other-key"}]
+    },
+    {
+      "client_info": {
+        "mobilesdk_app_id": "1:42:android:app",
+        "android_client_info": {"package_name": "com.example.demo_app"}
+      },
+      "api_key": [{"current_key": "demo-android-key"}]
+    }
+  ]
+}`
+
+const googleServiceInfoPlist = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>CLIENT_ID</key>
+	<string>42-abc.apps.googleusercontent.com</string>
+	<key>API_KEY</key>
+	<string>demo-ios-key</string>
+	<key>GCM_SENDER_ID</key>
+	<string>42</string>
+	<key>BUNDLE_ID</key>
+	<string>com.example.demoApp</string>
+	<key>PROJECT_ID</key>
+	<string>demo-project</string>
+	<key>IS_ADS_ENABLED</key>
+	<false></false>
+	<key>GOOGLE_APP_ID</key>
+	<string>1:42:ios:app</string>
+</dict>
+</plist>`
+
+func TestParseGoogleServices(t *testing.T) {
+	options, err := ParseGoogleServices([]byte(googleServicesJSON), "com.example.demo_app")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if options.AppID != "1:42:android:app" || options.APIKey != "demo-android-key" ||
+		options.MessagingSenderID != "42" || options.StorageBucket != "demo-project.appspot.com" {
+		t.Errorf("ParseGoogleServices() = %+v", options)
+	}
+
+	// Unknown packages fall back to the first app
+	if options, _ := ParseGoogleServices([]byte(googleServicesJSON), "com.acme.app"); options.AppID != "1:42:android:other" {
+		t.Errorf("fallback AppID = %q", options.AppID)
+	}
+
+	if _, err := ParseGoogleServices([]byte(`{"client": []}`), "com.example.demo_app"); err == nil {
+		t.Error("expected an error without Android apps")
+	}
+}
+
+func TestParseGoogleServiceInfo(t *testing.T) {
+	options, err := ParseGoogleServiceInfo([]byte(googleServiceInfoPlist))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if options.AppID != "1:42:ios:app" || options.APIKey != "demo-ios-key" ||
+		options.IOSBundleID != "com.example.demoApp" || options.IOSClientID != "42-abc.apps.googleusercontent.com" {
+		t.Errorf("ParseGoogleServiceInfo() = %+v", options)
+	}
+
+}
+
+func TestParseFirebaseOptionsRejectsUnsafeValues(t *testing.T) {
+	tests := []struct {
+		name  string
+		parse func() error
+	}{
+		{"API key", func() error {
+			return parseInfo(strings.Replace(googleServiceInfoPlist, "demo-ios-key", "it's", 1))
+		}},
+		{"iOS bundle ID", func() error {
+			return parseInfo(strings.Replace(googleServiceInfoPlist, "com.example.demoApp", "com.example.$demo", 1))
+		}},
+		{"iOS client ID", func() error {
+			return parseInfo(strings.Replace(googleServiceInfoPlist, "42-abc.apps", `42\abc.apps`, 1))
+		}},
+		{"storage bucket", func() error {
+			content := strings.Replace(googleServicesJSON, "demo-project.appspot.com", "demo'project.appspot.com", 1)
+			_, err := ParseGoogleServices([]byte(content), "com.example.demo_app")
+			return err
+		}},
+		{"missing app ID", func() error {
+			return parseInfo(strings.Replace(googleServiceInfoPlist, "1:42:ios:app", "", 1))
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.parse(); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func parseInfo(content string) error {
+	_, err := ParseGoogleServiceInfo([]byte(content))
+	return err
+}
@@ -0,0 +1,232 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"fline-cli/internal/templates"
+)
+
+// Firebase project files maintained for Firestore models
+const (
+	firestoreRulesFile   = "firestore.rules"
+	firestoreIndexesFile = "firestore.indexes.json"
+	firebaseJSONFile     = "firebase.json"
+	firestoreTestsDir    = "firestore-tests"
+)
+
+// rulesAnchor marks where collection blocks are added in firestore.rules
+const rulesAnchor = "// fline:collections"
+
+// firestoreEmulatorPort is the default port of the Firestore emulator
+const firestoreEmulatorPort = 8080
+
+// identifier matches the field names usable after a dot in rules and as
+// JavaScript property names
+var identifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// collectionID matches the collection IDs usable in a rules path, a file
+// name and a Dart string literal as they are
+var collectionID = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+// rulesChecks returns the security rules conditions checking the type of
+// every field of a document named data. Dynamic fields are not checked.
+func rulesChecks(fields []templates.Field) []string {
+	var checks []string
+	for _, f := range fields {
+		if t := rulesType(f.Type); t != "" {
+			checks = append(checks, rulesField(f.Name)+" is "+t)
+		}
+	}
+	return checks
+}
+
+// rulesType maps a Dart field type to a security rules type
+func rulesType(dartType string) string {
+	switch {
+	case dartType == "int":
+		return "int"
+	case dartType == "double":
+		// Whole numbers written from Dart may be stored as integers
+		return "number"
+	case dartType == "bool":
+		return "bool"
+	case dartType == "String":
+		return "string"
+	case strings.HasPrefix(dartType, "List<"):
+		return "list"
+	case strings.HasPrefix(dartType, "Map<"):
+		return "map"
+	default:
+		return ""
+	}
+}
+
+// rulesField returns the expression reading a field of data
+func rulesField(name string) string {
+	if identifier.MatchString(name) {
+		return "data." + name
+	}
+	return "data['" + name + "']"
+}
+
+// testDocument returns the JavaScript properties of a document passing the
+// checks of rulesChecks, owned by the test user alice
+func testDocument(fields []templates.Field, owner string) []string {
+	var properties []string
+	for _, f := range fields {
+		key := f.Name
+		if !identifier.MatchString(key) {
+			key = "'" + key + "'"
+		}
+
+		value := "null"
+		switch {
+		case f.Name == owner:
+			value = "'alice'"
+		case f.Type == "int":
+			value = "1"
+		case f.Type == "double":
+			value = "1.5"
+		case f.Type == "bool":
+			value = "true"
+		case f.Type == "String":
+			value = "'text'"
+		case strings.HasPrefix(f.Type, "List<"):
+			value = "[]"
+		case strings.HasPrefix(f.Type, "Map<"):
+			value = "{}"
+		}
+		properties = append(properties, key+": "+value)
+	}
+	return properties
+}
+
+// generateFirestoreRules adds the collection of the model to firestore.rules,
+// replacing the block of a previous run, and makes sure the indexes,
+// firebase.json and the rules tests are set up
+func (g *ModelGenerator) generateFirestoreRules() error {
+	data := g.data()
+
+	if !g.writer.PathExists(firestoreRulesFile) {
+		if err := g.render("firebase/firestore.rules", firestoreRulesFile); err != nil {
+			return err
+		}
+	}
+	block, err := g.loader.Render("model/firestore_rules", data)
+	if err != nil {
+		return err
+	}
+	err = editFile(g.writer, g.logger, firestoreRulesFile, func(source string) (string, bool, error) {
+		return upsertRulesBlock(source, data.Collection, block)
+	})
+	if err != nil {
+		return err
+	}
+
+	if !g.writer.PathExists(firestoreIndexesFile) {
+		if err := g.render("firebase/firestore.indexes.json", firestoreIndexesFile); err != nil {
+			return err
+		}
+	}
+	if err := g.configureFirebaseJSON(); err != nil {
+		return err
+	}
+
+	if !g.writer.PathExists(firestoreTestsDir + "/package.json") {
+		if err := g.render("firebase/firestore_tests_package.json", firestoreTestsDir+"/package.json"); err != nil {
+			return err
+		}
+	}
+	return g.render("model/firestore_rules_test.js",
+		fmt.Sprintf("%s/%s.test.js", firestoreTestsDir, data.Collection))
+}
+
+// upsertRulesBlock replaces the block of collection in a firestore.rules
+// source, or adds it before the rulesAnchor line. Blocks start with a
+// "// fline:begin <collection>" line and end with a
+// "// fline:end <collection>" line.
+func upsertRulesBlock(source, collection, block string) (string, bool, error) {
+	begin := regexp.MustCompile(`(?m)^[ \t]*// fline:begin ` + regexp.QuoteMeta(collection) + `[ \t]*\n`)
+	end := regexp.MustCompile(`(?m)^[ \t]*// fline:end ` + regexp.QuoteMeta(collection) + `[ \t]*(?:\n|$)`)
+
+	if loc := begin.FindStringIndex(source); loc != nil {
+		endLoc := end.FindStringIndex(source[loc[0]:])
+		if endLoc == nil {
+			return source, false, fmt.Errorf("block %s is not closed by // fline:end %s", collection, collection)
+		}
+		updated := source[:loc[0]] + block + source[loc[0]+endLoc[1]:]
+		return updated, updated != source, nil
+	}
+
+	anchor := regexp.MustCompile(`(?m)^[ \t]*` + regexp.QuoteMeta(rulesAnchor) + `[ \t]*$`)
+	loc := anchor.FindStringIndex(source)
+	if loc == nil {
+		return source, false, fmt.Errorf("no %q line to add the %s rules before", rulesAnchor, collection)
+	}
+	return source[:loc[0]] + block + "\n" + source[loc[0]:], true, nil
+}
+
+// configureFirebaseJSON points firebase.json to the rules and indexes and
+// sets up the Firestore emulator, keeping what is already configured
+func (g *ModelGenerator) configureFirebaseJSON() error {
+	if g.writer.PathExists(firebaseJSONFile) {
+		return editFile(g.writer, g.logger, firebaseJSONFile, addFirestoreSettings)
+	}
+
+	content, _, err := addFirestoreSettings("{}")
+	if err != nil {
+		return err
+	}
+	return g.writer.WriteFile(firebaseJSONFile, content)
+}
+
+// addFirestoreSettings adds the Firestore settings missing from the
+// firebase.json source. Keys end up sorted.
+func addFirestoreSettings(source string) (string, bool, error) {
+	settings := map[string]json.RawMessage{}
+	if err := json.Unmarshal([]byte(source), &settings); err != nil {
+		return source, false, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	changed := false
+	if _, ok := settings["firestore"]; !ok {
+		settings["firestore"] = mustMarshal(map[string]string{
+			"rules":   firestoreRulesFile,
+			"indexes": firestoreIndexesFile,
+		})
+		changed = true
+	}
+
+	emulators := map[string]json.RawMessage{}
+	if raw, ok := settings["emulators"]; ok {
+		if err := json.Unmarshal(raw, &emulators); err != nil {
+			return source, false, fmt.Errorf("invalid emulators: %w", err)
+		}
+	}
+	if _, ok := emulators["firestore"]; !ok {
+		emulators["firestore"] = mustMarshal(map[string]int{"port": firestoreEmulatorPort})
+		settings["emulators"] = mustMarshal(emulators)
+		changed = true
+	}
+
+	if !changed {
+		return source, false, nil
+	}
+	content, err := json.MarshalIndent(settings, "", "  ")
+	if err != nil {
+		return source, false, err
+	}
+	return string(content) + "\n", true, nil
+}
+
+// mustMarshal encodes values that always encode
+func mustMarshal(v interface{}) json.RawMessage {
+	content, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return content
+}
@@ -0,0 +1,61 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUpsertRulesBlock(t *testing.T) {
+	source := `service cloud.firestore {
+  match /databases/{database}/documents {
+    // fline:collections
+  }
+}
+`
+	block := "    // fline:begin tasks\n    match /tasks/{id} {}\n    // fline:end tasks\n"
+
+	added, changed, err := upsertRulesBlock(source, "tasks", block)
+	if err != nil || !changed {
+		t.Fatalf("upsertRulesBlock() = %v, %v", changed, err)
+	}
+	want := `service cloud.firestore {
+  match /databases/{database}/documents {
+    // fline:begin tasks
+    match /tasks/{id} {}
+    // fline:end tasks
+
+    // fline:collections
+  }
+}
+`
+	if added != want {
+		t.Fatalf("upsertRulesBlock() =\n%s", added)
+	}
+
+	if again, changed, _ := upsertRulesBlock(added, "tasks", block); changed || again != added {
+		t.Fatalf("upsertRulesBlock() is not idempotent:\n%s", again)
+	}
+
+	replaced, _, err := upsertRulesBlock(added, "tasks", strings.Replace(block, "{}", "{ allow read; }", 1))
+	if err != nil || strings.Count(replaced, "match /tasks/") != 1 || !strings.Contains(replaced, "allow read;") {
+		t.Fatalf("block not replaced: %v\n%s", err, replaced)
+	}
+
+	if _, _, err := upsertRulesBlock("service cloud.firestore {}\n", "tasks", block); err == nil {
+		t.Fatal("expected an error without the anchor")
+	}
+}
+
+func TestAddFirestoreSettings(t *testing.T) {
+	source := `{"flutter": {"platforms": {}}, "emulators": {"auth": {"port": 9099}}}`
+
+	got, changed, err := addFirestoreSettings(source)
+	if err != nil || !changed {
+		t.Fatalf("addFirestoreSettings() = %v, %v", changed, err)
+	}
+	assertContains(t, got, `"flutter": {`, `"auth": {`, `"port": 9099`, `"port": 8080`, `"rules": "firestore.rules"`)
+
+	if _, changed, _ := addFirestoreSettings(got); changed {
+		t.Fatal("addFirestoreSettings() is not idempotent")
+	}
+}
@@ -0,0 +1,98 @@
+package generator
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"fline-cli/internal/utils"
+)
+
+var update = flag.Bool("update", false, "update golden files in testdata")
+
+// assertGolden compares every file under dir with the golden tree under
+// testdata/<golden>. Run "go test ./internal/generator -update" to
+// regenerate the golden files after an intentional change.
+func assertGolden(t *testing.T, dir, golden string) {
+	t.Helper()
+
+	goldenDir := filepath.Join("testdata", golden)
+	got := readTree(t, dir)
+
+	if *update {
+		if err := os.RemoveAll(goldenDir); err != nil {
+			t.Fatal(err)
+		}
+		writer := utils.NewFileWriter(goldenDir)
+		for path, content := range got {
+			if err := writer.WriteFile(path, content); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return
+	}
+
+	want := readTree(t, goldenDir)
+
+	for _, path := range sortedKeys(want) {
+		content, ok := got[path]
+		if !ok {
+			t.Errorf("missing file %s", path)
+			continue
+		}
+		if content != want[path] {
+			t.Errorf("%s differs from %s (run with -update to accept)\n--- got ---\n%s",
+				path, filepath.Join(goldenDir, path), content)
+		}
+	}
+
+	for _, path := range sortedKeys(got) {
+		if _, ok := want[path]; !ok {
+			t.Errorf("unexpected file %s (run with -update to accept)", path)
+		}
+	}
+}
+
+// readTree returns the content of every file under dir, keyed by slash path
+func readTree(t *testing.T, dir string) map[string]string {
+	t.Helper()
+
+	files := map[string]string{}
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = string(content)
+		return nil
+	})
+	if err != nil && !os.IsNotExist(err) {
+		t.Fatal(err)
+	}
+
+	return files
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// isolateTemplates makes sure no user-level template overrides leak into tests
+func isolateTemplates(t *testing.T) {
+	t.Helper()
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+}
@@ -0,0 +1,504 @@
+package generator
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"fline-cli/internal/config"
+	"fline-cli/internal/dartedit"
+	"fline-cli/internal/pubspec"
+	"fline-cli/internal/templates"
+	"fline-cli/internal/ui"
+	"fline-cli/internal/utils"
+)
+
+// Integrations that fline add can add to an existing project
+const (
+	IntegrationFirebase      = "firebase"
+	IntegrationSupabase      = "supabase"
+	IntegrationNotifications = "notifications"
+)
+
+// Integrations lists the integrations in help order
+var Integrations = []string{IntegrationFirebase, IntegrationSupabase, IntegrationNotifications}
+
+// Files edited when an integration is wired into a project
+const (
+	mainFile      = "lib/main.dart"
+	injectorFile  = "lib/di/dependency_injector.dart"
+	providersFile = "lib/di/providers.dart"
+)
+
+// diProvider is an entry of a registration list of the Pine DI, e.g.
+// _providers in lib/di/providers.dart
+type diProvider struct {
+	Type string // Provided type, identifies the entry
+	Code string // Entry source, without indentation
+}
+
+// diList is a registration list of the Pine DI
+type diList struct {
+	File    string // part of lib/di/dependency_injector.dart
+	Name    string // List variable
+	Wrapper string // Provider class of the entries, e.g. Provider
+}
+
+// Registration lists of the Pine DI
+var (
+	providersList    = diList{File: providersFile, Name: "_providers", Wrapper: "Provider"}
+	repositoriesList = diList{File: "lib/di/repositories.dart", Name: "_repositories", Wrapper: "RepositoryProvider"}
+)
+
+// Platform files flutter create writes the application ID to
+var (
+	androidBuildFiles = []string{"android/app/build.gradle.kts", "android/app/build.gradle"}
+	iosProjectFile    = "ios/Runner.xcodeproj/project.pbxproj"
+)
+
+// Application ID declarations, in order of preference
+var (
+	applicationIDPatterns = []*regexp.Regexp{
+		regexp.MustCompile(`(?m)^\s*applicationId\s*=?\s*["']([^"']+)["']`),
+		regexp.MustCompile(`(?m)^\s*namespace\s*=?\s*["']([^"']+)["']`),
+	}
+	bundleIDPattern = regexp.MustCompile(`PRODUCT_BUNDLE_IDENTIFIER = "?([^";]+)"?;`)
+)
+
+// ConfigFromProject rebuilds the configuration fline create would have used
+// for an existing project, based on its dependencies, its services and the
+// application ID of its platform apps
+func ConfigFromProject(writer *utils.FileWriter, spec *pubspec.Pubspec) (*config.ProjectConfig, error) {
+	org, err := projectOrganization(writer)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := config.DefaultProjectConfig()
+	cfg.ProjectName = spec.Name()
+	cfg.OrganizationName = org
+	cfg.UseFirebase = spec.HasDependency("firebase_core")
+	cfg.UseSupabase = spec.HasDependency("supabase_flutter")
+	cfg.UseRest = writer.PathExists(refreshInterceptorFile)
+
+	if cfg.UseSupabase {
+		cfg.Supabase, _ = config.ParseSupabaseModules(supabaseModules(writer))
+	}
+	if cfg.UseFirebase {
+		var modules []string
+		for _, module := range config.FirebaseModules {
+			if deps := templates.FirebaseModuleDependencies(module); spec.HasDependency(deps[0].Name) {
+				modules = append(modules, module)
+			}
+		}
+		cfg.Firebase, _ = config.ParseFirebaseModules(modules)
+	}
+
+	// FCM comes with the messaging module, set by Normalize
+	switch {
+	case spec.HasDependency("onesignal_flutter"):
+		cfg.EnableNotifications = true
+		cfg.NotificationService = config.NotificationsOneSignal
+	case !cfg.Firebase.EnableMessaging && spec.HasDependency("flutter_local_notifications"):
+		cfg.EnableNotifications = true
+		cfg.NotificationService = config.NotificationsLocal
+	}
+
+	cfg.Normalize()
+	return cfg, nil
+}
+
+// projectOrganization returns the organization the project was created
+// with: the application ID of its Android or iOS app without the last
+// segment, e.g. com.mycompany for com.mycompany.my_app
+func projectOrganization(writer *utils.FileWriter) (string, error) {
+	for _, id := range applicationIDs(writer) {
+		if i := strings.LastIndex(id, "."); i > 0 && utils.ValidateOrganization(id[:i]) == nil {
+			return id[:i], nil
+		}
+	}
+	return "", fmt.Errorf("cannot find the organization of the project: no application ID in %s or %s", strings.Join(androidBuildFiles, ", "), iosProjectFile)
+}
+
+// applicationIDs returns the application IDs declared by the platform
+// files, Android first
+func applicationIDs(writer *utils.FileWriter) []string {
+	var ids []string
+	for _, pattern := range applicationIDPatterns {
+		for _, path := range androidBuildFiles {
+			if content, err := writer.ReadFile(path); err == nil {
+				if m := pattern.FindStringSubmatch(content); m != nil {
+					ids = append(ids, m[1])
+				}
+			}
+		}
+	}
+
+	if content, err := writer.ReadFile(iosProjectFile); err == nil {
+		for _, m := range bundleIDPattern.FindAllStringSubmatch(content, -1) {
+			// The test target is <bundle id>.RunnerTests
+			if !strings.HasSuffix(m[1], ".RunnerTests") {
+				ids = append(ids, m[1])
+			}
+		}
+	}
+	return ids
+}
+
+// IntegrationGenerator adds a backend integration to an existing project
+type IntegrationGenerator struct {
+	writer  *utils.FileWriter
+	logger  *ui.Logger
+	modules []string // Backend modules to add
+
+	// Notification provider, see SetNotificationProvider
+	notificationProvider string
+
+	// Native Firebase configuration files to copy into the project
+	androidConfig, iosConfig string
+}
+
+// NewIntegrationGenerator creates a generator for the project rooted at
+// root. Files rendered from templates are written according to policy;
+// the pubspec, DI and main.dart are always edited in place.
+func NewIntegrationGenerator(root string, policy utils.ConflictPolicy) *IntegrationGenerator {
+	writer := utils.NewFileWriter(root)
+	writer.SetConflictPolicy(policy)
+
+	return &IntegrationGenerator{
+		writer: writer,
+		logger: ui.NewLogger("add"),
+	}
+}
+
+// SetModules selects the Firebase or Supabase modules to add, on top of
+// those the project already uses. Without a selection, a project without
+// the backend gets its default modules.
+func (g *IntegrationGenerator) SetModules(modules []string) {
+	g.modules = modules
+}
+
+// SetFirebaseConfigFiles sets the google-services.json and
+// GoogleService-Info.plist files to copy into the project when adding
+// Firebase. Either may be empty. Files already in android/app and
+// ios/Runner are used anyway.
+func (g *IntegrationGenerator) SetFirebaseConfigFiles(android, ios string) {
+	g.androidConfig = android
+	g.iosConfig = ios
+}
+
+// SetNotificationProvider selects the provider used when adding
+// notifications: fcm, onesignal or local. By default, projects with
+// Firebase use FCM and the others local notifications.
+func (g *IntegrationGenerator) SetNotificationProvider(provider string) {
+	g.notificationProvider = provider
+}
+
+// Skipped returns the files kept because they already existed
+func (g *IntegrationGenerator) Skipped() []string {
+	return g.writer.Skipped()
+}
+
+// Add adds an integration. Running it again on the same project changes
+// nothing.
+func (g *IntegrationGenerator) Add(integration string) error {
+	path := g.writer.GetFullPath("pubspec.yaml")
+	spec, err := pubspec.Load(path)
+	if err != nil {
+		return err
+	}
+	cfg, err := ConfigFromProject(g.writer, spec)
+	if err != nil {
+		return err
+	}
+
+	var deps []templates.Dependency
+	switch integration {
+	case IntegrationFirebase:
+		if len(g.modules) > 0 {
+			modules, err := config.ParseFirebaseModules(append(cfg.Firebase.Modules(), g.modules...))
+			if err != nil {
+				return err
+			}
+			cfg.Firebase = modules
+		} else if !cfg.UseFirebase {
+			cfg.Firebase = config.DefaultFirebaseConfig()
+			// Another backend authenticates the user already
+			if cfg.AuthBackend() != "" {
+				cfg.Firebase.EnableAuth = false
+			}
+		}
+		cfg.UseFirebase = true
+		cfg.Firebase.AndroidConfig = g.androidConfig
+		cfg.Firebase.IOSConfig = g.iosConfig
+		cfg.Normalize()
+		if err := cfg.ValidateRest(); err != nil {
+			return err
+		}
+		if err := cfg.ValidateNotifications(); err != nil {
+			return err
+		}
+		deps = templates.FirebaseDependencies(cfg.Firebase)
+		if cfg.Firebase.EnableMessaging {
+			deps = append(deps, templates.NotificationDependencies(config.NotificationsFCM)...)
+		}
+	case IntegrationSupabase:
+		if len(g.modules) > 0 || cfg.UseSupabase {
+			modules, err := config.ParseSupabaseModules(append(supabaseModules(g.writer), g.modules...))
+			if err != nil {
+				return err
+			}
+			cfg.Supabase = modules
+		} else if cfg.UseRest {
+			// The REST API authenticates the user already
+			cfg.Supabase.EnableAuth = false
+		}
+		cfg.UseSupabase = true
+		if err := cfg.ValidateRest(); err != nil {
+			return err
+		}
+		deps = templates.SupabaseDependencies()
+	case IntegrationNotifications:
+		provider, err := g.notificationService(cfg)
+		if err != nil {
+			return err
+		}
+		cfg.EnableNotifications = true
+		cfg.NotificationService = provider
+		if provider == config.NotificationsFCM {
+			if !cfg.UseFirebase {
+				return fmt.Errorf("FCM notifications need Firebase: run fline add firebase first")
+			}
+			cfg.Firebase.EnableMessaging = true
+			deps = templates.FirebaseModuleDependencies(config.FirebaseMessaging)
+		}
+		if err := cfg.ValidateNotifications(); err != nil {
+			return err
+		}
+		deps = append(deps, templates.NotificationDependencies(provider)...)
+	default:
+		return fmt.Errorf("unknown integration %q (use %s)", integration, strings.Join(Integrations, ", "))
+	}
+
+	changed, err := addMissingDependencies(spec, deps)
+	if err != nil {
+		return err
+	}
+	if changed {
+		if err := spec.Save(path); err != nil {
+			return err
+		}
+		g.logger.Success("Updated pubspec.yaml")
+	}
+
+	switch integration {
+	case IntegrationFirebase:
+		return NewFirebaseGenerator(cfg, g.writer).Generate()
+	case IntegrationSupabase:
+		return NewSupabaseGenerator(cfg, g.writer).Generate()
+	default:
+		return NewNotificationGenerator(cfg, g.writer).Generate()
+	}
+}
+
+// notificationService returns the notification provider to add to a
+// project configured as cfg. Switching an existing provider isn't
+// supported.
+func (g *IntegrationGenerator) notificationService(cfg *config.ProjectConfig) (string, error) {
+	provider := g.notificationProvider
+	if cfg.EnableNotifications {
+		if provider != "" && provider != cfg.NotificationService {
+			return "", fmt.Errorf("the project already sends notifications through %s", cfg.NotificationService)
+		}
+		return cfg.NotificationService, nil
+	}
+	if provider != "" {
+		return provider, nil
+	}
+	if cfg.UseFirebase {
+		return config.NotificationsFCM, nil
+	}
+	return config.NotificationsLocal, nil
+}
+
+// supabaseModules returns the Supabase modules whose service the project
+// already has
+func supabaseModules(writer *utils.FileWriter) []string {
+	services := map[string]string{
+		config.SupabaseAuth:     "lib/network/service/supabase_auth_service.dart",
+		config.SupabaseDatabase: "lib/network/service/supabase_database_service.dart",
+		config.SupabaseStorage:  "lib/network/service/supabase_storage_service.dart",
+	}
+
+	var modules []string
+	for _, module := range config.SupabaseModules {
+		if writer.PathExists(services[module]) {
+			modules = append(modules, module)
+		}
+	}
+	return modules
+}
+
+// addMissingDependencies adds the dependencies the project doesn't have yet.
+// Existing constraints are left alone. It reports whether spec changed.
+func addMissingDependencies(spec *pubspec.Pubspec, deps []templates.Dependency) (bool, error) {
+	changed := false
+	for _, dep := range deps {
+		if spec.HasDependency(dep.Name) {
+			continue
+		}
+		_, err := spec.SetDependency(pubspec.SectionDependencies, pubspec.Dependency{
+			Name:    dep.Name,
+			Version: dep.Version,
+			SDK:     dep.SDK,
+		})
+		if err != nil {
+			return changed, fmt.Errorf("failed to add %s: %w", dep.Name, err)
+		}
+		changed = true
+	}
+	return changed, nil
+}
+
+// registerProviders adds providers and the imports they need to the Pine DI
+// of the project. Projects without the DI get the snippet to add by hand.
+func registerProviders(writer *utils.FileWriter, logger *ui.Logger, imports []string, providers []diProvider) error {
+	return registerDI(writer, logger, providersList, imports, providers)
+}
+
+// registerDI adds entries to a registration list of the Pine DI, and the
+// imports they need to lib/di/dependency_injector.dart
+func registerDI(writer *utils.FileWriter, logger *ui.Logger, list diList, imports []string, providers []diProvider) error {
+	if !writer.PathExists(injectorFile) || !writer.PathExists(list.File) {
+		var snippet []string
+		for _, p := range providers {
+			snippet = append(snippet, p.Code)
+		}
+		logger.Warning(fmt.Sprintf("No Pine DI found, add these entries to %s by hand:", list.Name))
+		logger.Info(strings.Join(snippet, "\n"))
+		return nil
+	}
+
+	err := editFile(writer, logger, injectorFile, func(source string) (string, bool, error) {
+		changed := false
+		for _, uri := range imports {
+			var added bool
+			source, added = dartedit.AddImport(source, uri)
+			changed = changed || added
+		}
+		return source, changed, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return editFile(writer, logger, list.File, func(source string) (string, bool, error) {
+		changed := false
+		for _, p := range providers {
+			var added bool
+			var err error
+			source, added, err = dartedit.AddListItem(source, list.Name, list.Wrapper+"<"+p.Type+">", p.Code)
+			if err != nil {
+				return source, false, err
+			}
+			changed = changed || added
+		}
+		return source, changed, nil
+	})
+}
+
+// wireMain adds statements (and the imports they need) to main() in
+// lib/main.dart, right before runApp
+func wireMain(writer *utils.FileWriter, logger *ui.Logger, imports []string, statements []string) error {
+	if !writer.PathExists(mainFile) {
+		logger.Warning("No lib/main.dart found, add this to main() by hand:")
+		logger.Info(strings.Join(statements, "\n"))
+		return nil
+	}
+
+	return editFile(writer, logger, mainFile, func(source string) (string, bool, error) {
+		changed := false
+		for _, statement := range statements {
+			var added bool
+			var err error
+			source, added, err = dartedit.AddMainStatement(source, statement)
+			if err != nil {
+				return source, false, err
+			}
+			changed = changed || added
+		}
+		for _, uri := range imports {
+			var added bool
+			source, added = dartedit.AddImport(source, uri)
+			changed = changed || added
+		}
+		return source, changed, nil
+	})
+}
+
+// editFile applies edit to an existing project file. Edits bypass the
+// conflict policy: they only add what is missing.
+func editFile(writer *utils.FileWriter, logger *ui.Logger, path string, edit func(string) (string, bool, error)) error {
+	source, err := writer.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	updated, changed, err := edit(source)
+	if err != nil {
+		return fmt.Errorf("failed to update %s: %w", path, err)
+	}
+	if !changed {
+		return nil
+	}
+
+	if err := os.WriteFile(writer.GetFullPath(path), []byte(updated), 0644); err != nil {
+		return err
+	}
+	logger.Success("Updated " + path)
+	return nil
+}
+
+// packageImport returns the package: URI of a file under lib/
+func packageImport(packageName, path string) string {
+	return "package:" + packageName + "/" + strings.TrimPrefix(path, "lib/")
+}
+
+// addGitignoreEntries appends the missing entries to .gitignore under a
+// comment, creating the file when needed
+func addGitignoreEntries(writer *utils.FileWriter, logger *ui.Logger, comment string, entries ...string) error {
+	content := ""
+	if writer.PathExists(".gitignore") {
+		var err error
+		if content, err = writer.ReadFile(".gitignore"); err != nil {
+			return err
+		}
+	}
+
+	present := map[string]bool{}
+	for _, line := range strings.Split(content, "\n") {
+		present[strings.TrimSpace(line)] = true
+	}
+
+	var missing []string
+	for _, entry := range entries {
+		if !present[entry] {
+			missing = append(missing, entry)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	if content != "" {
+		content = strings.TrimRight(content, "\n") + "\n\n"
+	}
+	content += "# " + comment + "\n" + strings.Join(missing, "\n") + "\n"
+
+	if err := os.WriteFile(writer.GetFullPath(".gitignore"), []byte(content), 0644); err != nil {
+		return err
+	}
+	logger.Success("Updated .gitignore")
+	return nil
+}
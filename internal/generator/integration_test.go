@@ -0,0 +1,318 @@
+package generator
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"fline-cli/internal/config"
+	"fline-cli/internal/fluttertest"
+	"fline-cli/internal/pubspec"
+	"fline-cli/internal/utils"
+)
+
+// newTestProject creates a project without integrations and returns its root
+func newTestProject(t *testing.T) string {
+	t.Helper()
+	isolateTemplates(t)
+
+	target := t.TempDir()
+	gen := NewProjectGenerator(newTestConfig(target))
+	gen.SetFlutterFactory(fluttertest.New().Factory())
+	if err := gen.Generate(context.Background()); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	return filepath.Join(target, "demo_app")
+}
+
+func TestIntegrationGeneratorAdd(t *testing.T) {
+	root := newTestProject(t)
+	gen := NewIntegrationGenerator(root, utils.ConflictSkip)
+
+	for _, integration := range []string{IntegrationFirebase, IntegrationNotifications, IntegrationSupabase} {
+		if err := gen.Add(integration); err != nil {
+			t.Fatalf("Add(%s) error = %v", integration, err)
+		}
+	}
+	first := readTree(t, root)
+
+	for _, path := range []string{
+		"lib/utils/firebase_initializer.dart",
+		"lib/network/service/auth_service.dart",
+		"lib/network/service/notification_service.dart",
+		"lib/network/service/fcm_notification_service.dart",
+		"lib/routers/notification_router.dart",
+		"lib/utils/supabase_client.dart",
+		"lib/network/service/supabase_auth_service.dart",
+	} {
+		if _, ok := first[path]; !ok {
+			t.Errorf("missing %s", path)
+		}
+	}
+
+	assertContains(t, first["pubspec.yaml"], "firebase_core: ^4.5.0", "firebase_messaging: ^16.1.2", "flutter_local_notifications: ^19.5.0", "supabase_flutter: ^2.12.0")
+	assertContains(t, first["lib/main.dart"],
+		"    WidgetsFlutterBinding.ensureInitialized();\n    await FirebaseInitializer.initialize();\n    FirebaseMessaging.onBackgroundMessage(firebaseMessagingBackgroundHandler);\n    await SupabaseConfig.initialize();\n    runApp(const App());",
+		"import 'package:demo_app/utils/supabase_client.dart';",
+		"import 'package:demo_app/network/service/fcm_notification_service.dart';")
+	assertContains(t, first["lib/di/providers.dart"],
+		"Provider<AuthService>(", "create: (context) => FcmNotificationService(", "Provider<NotificationRouter>(", "Provider<SupabaseAuthService>(")
+	assertContains(t, first["lib/di/dependency_injector.dart"],
+		"import 'package:firebase_auth/firebase_auth.dart';", "import 'package:supabase_flutter/supabase_flutter.dart';")
+
+	// Running every integration again changes nothing
+	for _, integration := range Integrations {
+		if err := NewIntegrationGenerator(root, utils.ConflictSkip).Add(integration); err != nil {
+			t.Fatalf("Add(%s) again error = %v", integration, err)
+		}
+	}
+	if second := readTree(t, root); !reflect.DeepEqual(first, second) {
+		for path := range second {
+			if first[path] != second[path] {
+				t.Errorf("%s changed on the second run:\n%s", path, second[path])
+			}
+		}
+	}
+}
+
+func TestIntegrationGeneratorAddsFirebaseModules(t *testing.T) {
+	root := newTestProject(t)
+	if err := NewIntegrationGenerator(root, utils.ConflictSkip).Add(IntegrationFirebase); err != nil {
+		t.Fatal(err)
+	}
+
+	gen := NewIntegrationGenerator(root, utils.ConflictSkip)
+	gen.SetModules([]string{"storage", "crashlytics"})
+	if err := gen.Add(IntegrationFirebase); err != nil {
+		t.Fatal(err)
+	}
+
+	files := readTree(t, root)
+	assertContains(t, files["pubspec.yaml"], "firebase_auth:", "cloud_firestore:", "firebase_storage:", "firebase_crashlytics:")
+	assertContains(t, files["lib/di/providers.dart"], "Provider<FirebaseFirestore>(", "Provider<StorageService>(")
+	assertContains(t, files["lib/main.dart"], "FlutterError.onError = FirebaseCrashlytics.instance.recordFlutterFatalError;")
+	if _, ok := files["lib/network/service/analytics_service.dart"]; ok {
+		t.Error("analytics was not selected")
+	}
+	if strings.Contains(files["pubspec.yaml"], "firebase_messaging") {
+		t.Error("messaging was not selected")
+	}
+}
+
+func TestIntegrationGeneratorFirebaseOptions(t *testing.T) {
+	root := newTestProject(t)
+	source := filepath.Join(t.TempDir(), "google-services.json")
+	if err := os.WriteFile(source, []byte(googleServicesJSON), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	gen := NewIntegrationGenerator(root, utils.ConflictSkip)
+	gen.SetFirebaseConfigFiles(source, "")
+	if err := gen.Add(IntegrationFirebase); err != nil {
+		t.Fatal(err)
+	}
+
+	files := readTree(t, root)
+	assertContains(t, files["android/app/google-services.json"], `"project_id": "demo-project"`)
+	assertContains(t, files["lib/firebase_options.dart"],
+		"return android;", "apiKey: 'demo-android-key',", "appId: '1:42:android:app',", "throw _notConfigured('iOS');")
+	assertContains(t, files["lib/utils/firebase_initializer.dart"], "import 'package:demo_app/firebase_options.dart';")
+}
+
+func TestIntegrationGeneratorAddsSupabaseModules(t *testing.T) {
+	root := newTestProject(t)
+	if err := NewIntegrationGenerator(root, utils.ConflictSkip).Add(IntegrationSupabase); err != nil {
+		t.Fatal(err)
+	}
+
+	// Credentials filled in by hand survive later runs, even with overwrite
+	env := filepath.Join(root, "env/dev.env")
+	if err := os.WriteFile(env, []byte("SUPABASE_URL=https://real.supabase.co\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	gen := NewIntegrationGenerator(root, utils.ConflictOverwrite)
+	gen.SetModules([]string{"storage"})
+	if err := gen.Add(IntegrationSupabase); err != nil {
+		t.Fatal(err)
+	}
+
+	files := readTree(t, root)
+	assertContains(t, files["env/dev.env"], "https://real.supabase.co")
+	assertContains(t, files["lib/di/providers.dart"],
+		"Provider<SupabaseAuthService>(", "Provider<SupabaseDatabaseService>(", "Provider<SupabaseStorageService>(")
+	assertContains(t, files["lib/utils/supabase_client.dart"], "String.fromEnvironment('SUPABASE_URL')")
+	assertContains(t, files[".gitignore"], "env/*.env\n!env/example.env\n")
+	if strings.Count(files[".gitignore"], "env/*.env") != 1 {
+		t.Errorf(".gitignore entries duplicated:\n%s", files[".gitignore"])
+	}
+}
+
+func TestIntegrationGeneratorKeepsEditedFiles(t *testing.T) {
+	root := newTestProject(t)
+	if err := NewIntegrationGenerator(root, utils.ConflictSkip).Add(IntegrationSupabase); err != nil {
+		t.Fatal(err)
+	}
+
+	client := filepath.Join(root, "lib/utils/supabase_client.dart")
+	if err := os.WriteFile(client, []byte("// edited\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	gen := NewIntegrationGenerator(root, utils.ConflictSkip)
+	if err := gen.Add(IntegrationSupabase); err != nil {
+		t.Fatal(err)
+	}
+	if got := gen.Skipped(); !reflect.DeepEqual(got, []string{"lib/utils/supabase_client.dart"}) {
+		t.Errorf("Skipped() = %q", got)
+	}
+	if content, _ := os.ReadFile(client); string(content) != "// edited\n" {
+		t.Errorf("edited file was overwritten:\n%s", content)
+	}
+}
+
+func TestIntegrationGeneratorNotificationsNeedFirebase(t *testing.T) {
+	root := newTestProject(t)
+
+	gen := NewIntegrationGenerator(root, utils.ConflictSkip)
+	gen.SetNotificationProvider(config.NotificationsFCM)
+	err := gen.Add(IntegrationNotifications)
+	if err == nil || !strings.Contains(err.Error(), "fline add firebase") {
+		t.Fatalf("Add(notifications) error = %v", err)
+	}
+}
+
+func TestIntegrationGeneratorLocalNotifications(t *testing.T) {
+	root := newTestProject(t)
+
+	// Without Firebase, notifications are local
+	if err := NewIntegrationGenerator(root, utils.ConflictSkip).Add(IntegrationNotifications); err != nil {
+		t.Fatal(err)
+	}
+
+	files := readTree(t, root)
+	assertContains(t, files["pubspec.yaml"], "flutter_local_notifications: ^19.5.0")
+	assertContains(t, files["lib/di/providers.dart"],
+		"Provider<LocalNotificationService>(", "create: (context) => context.read<LocalNotificationService>(),", "Provider<NotificationRouter>(")
+	assertContains(t, files["lib/di/dependency_injector.dart"],
+		"import 'package:demo_app/app.dart';", "import 'package:demo_app/routers/notification_router.dart';")
+	if _, ok := files["lib/network/service/fcm_notification_service.dart"]; ok {
+		t.Error("FCM was not selected")
+	}
+
+	gen := NewIntegrationGenerator(root, utils.ConflictSkip)
+	gen.SetNotificationProvider(config.NotificationsOneSignal)
+	if err := gen.Add(IntegrationNotifications); err == nil {
+		t.Error("switching to OneSignal should fail")
+	}
+}
+
+func assertContains(t *testing.T, content string, want ...string) {
+	t.Helper()
+	for _, w := range want {
+		if !strings.Contains(content, w) {
+			t.Errorf("missing %q in:\n%s", w, content)
+		}
+	}
+}
+
+func TestConfigFromProjectOrganization(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "kotlin gradle",
+			files: map[string]string{"android/app/build.gradle.kts": "android {\n    namespace = \"com.acme.shop\"\n    defaultConfig {\n        applicationId = \"com.acme.store.shop\"\n    }\n}\n"},
+			want:  "com.acme.store",
+		},
+		{
+			name:  "groovy gradle namespace",
+			files: map[string]string{"android/app/build.gradle": "android {\n    namespace 'io.acme.shop'\n}\n"},
+			want:  "io.acme",
+		},
+		{
+			name: "ios bundle id",
+			files: map[string]string{"ios/Runner.xcodeproj/project.pbxproj": "PRODUCT_BUNDLE_IDENTIFIER = com.acme.shop.RunnerTests;\n" +
+				"PRODUCT_BUNDLE_IDENTIFIER = com.acme.shop;\n"},
+			want: "com.acme",
+		},
+		{
+			name:    "no platform files",
+			wantErr: true,
+		},
+		{
+			name:    "id without organization",
+			files:   map[string]string{"android/app/build.gradle.kts": "applicationId = \"shop\"\n"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writer := utils.NewFileWriter(t.TempDir())
+			for path, content := range tt.files {
+				if err := writer.WriteFile(path, content); err != nil {
+					t.Fatal(err)
+				}
+			}
+			spec, err := pubspec.Parse([]byte("name: shop\n"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cfg, err := ConfigFromProject(writer, spec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ConfigFromProject() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && cfg.OrganizationName != tt.want {
+				t.Errorf("OrganizationName = %q, want %q", cfg.OrganizationName, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntegrationGeneratorKeepsRestAuth(t *testing.T) {
+	isolateTemplates(t)
+
+	target := t.TempDir()
+	cfg := newTestConfig(target)
+	cfg.UseRest = true
+	gen := NewProjectGenerator(cfg)
+	gen.SetFlutterFactory(fluttertest.New().Factory())
+	if err := gen.Generate(context.Background()); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	root := filepath.Join(target, "demo_app")
+	before := readTree(t, root)
+
+	// Asking for the Firebase auth module is refused before writing anything
+	auth := NewIntegrationGenerator(root, utils.ConflictOverwrite)
+	auth.SetModules([]string{config.FirebaseAuth})
+	if err := auth.Add(IntegrationFirebase); err == nil {
+		t.Fatal("expected an error adding Firebase auth to a REST project")
+	}
+	if after := readTree(t, root); !reflect.DeepEqual(before, after) {
+		t.Error("project changed by a refused integration")
+	}
+
+	// The default modules leave auth to the REST API
+	if err := NewIntegrationGenerator(root, utils.ConflictOverwrite).Add(IntegrationFirebase); err != nil {
+		t.Fatalf("Add(firebase) error = %v", err)
+	}
+	after := readTree(t, root)
+	if after["lib/network/service/auth_service.dart"] != before["lib/network/service/auth_service.dart"] {
+		t.Error("REST AuthService replaced by the Firebase one")
+	}
+	if strings.Count(after["lib/di/providers.dart"], "Provider<AuthService>(") != 1 {
+		t.Errorf("AuthService registered twice:\n%s", after["lib/di/providers.dart"])
+	}
+	if strings.Contains(after["pubspec.yaml"], "firebase_auth") {
+		t.Error("firebase_auth added to a REST project")
+	}
+}
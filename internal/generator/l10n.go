@@ -0,0 +1,61 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"fline-cli/internal/templates"
+)
+
+// addArbStrings appends the strings missing from source, the content of an
+// ARB file, keeping its four-space indentation. The English file, the
+// template of the others, also gets their descriptions. The others leave
+// out the strings without an Italian text.
+func addArbStrings(source string, list []templates.LocalizedString, english bool) (string, bool, error) {
+	var existing map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(source), &existing); err != nil {
+		return source, false, fmt.Errorf("invalid ARB file: %w", err)
+	}
+
+	end := strings.LastIndex(source, "}")
+	body := strings.TrimRight(source[:end], " \t\n")
+	changed := false
+	for _, s := range list {
+		if _, ok := existing[s.Key]; ok {
+			continue
+		}
+		// Untranslated, gen-l10n falls back to the English string
+		if !english && s.Italian == "" {
+			continue
+		}
+		existing[s.Key] = nil
+
+		text := s.Italian
+		if english {
+			text = s.English
+		}
+		if !strings.HasSuffix(body, "{") {
+			body += ","
+		}
+		body += fmt.Sprintf("\n    %s: %s", jsonString(s.Key), jsonString(text))
+		if english {
+			body += fmt.Sprintf(",\n    %s: {\n        \"description\": %s\n    }", jsonString("@"+s.Key), jsonString(s.Description))
+		}
+		changed = true
+	}
+	if !changed {
+		return source, false, nil
+	}
+	return body + "\n" + source[end:], true, nil
+}
+
+// jsonString quotes s as a JSON string, leaving HTML characters alone
+func jsonString(s string) string {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(s) // Strings always encode
+	return strings.TrimSuffix(buf.String(), "\n")
+}
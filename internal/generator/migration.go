@@ -0,0 +1,114 @@
+package generator
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"fline-cli/internal/templates"
+)
+
+// migrationsDir holds the Supabase CLI migrations of a project
+const migrationsDir = "supabase/migrations"
+
+// migrationTimestamp is the layout of the Supabase CLI migration versions
+const migrationTimestamp = "20060102150405"
+
+// ownerFields are the field names recognized as the ID of the owning user
+var ownerFields = []string{"owner_id", "ownerId", "user_id", "userId"}
+
+// plainIdentifier matches the SQL identifiers that need no quotes
+var plainIdentifier = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+// ownerField returns the field holding the owner user ID, or ""
+func ownerField(fields []templates.Field) string {
+	for _, name := range ownerFields {
+		for _, f := range fields {
+			if f.Name == name && f.Type == "String" {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// sqlColumns returns the column definitions of the table storing a model.
+// A uuid primary key is added when the model has no id field.
+func sqlColumns(fields []templates.Field, owner string) []string {
+	// The primary key comes first
+	columns := []string{"id uuid primary key default gen_random_uuid()"}
+	for _, f := range fields {
+		if f.Name == "id" && f.Type == "int" {
+			columns[0] = "id bigint generated by default as identity primary key"
+		}
+	}
+
+	for _, f := range fields {
+		name := sqlIdentifier(f.Name)
+		switch {
+		case f.Name == "id":
+			continue
+		case f.Name == owner:
+			columns = append(columns, name+" uuid not null default auth.uid() references auth.users (id) on delete cascade")
+		default:
+			column := name + " " + sqlType(f.Type)
+			if f.Type != "dynamic" {
+				column += " not null"
+			}
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
+
+// sqlType maps a Dart field type to a Postgres column type
+func sqlType(dartType string) string {
+	switch dartType {
+	case "int":
+		return "bigint"
+	case "double":
+		return "double precision"
+	case "bool":
+		return "boolean"
+	case "String":
+		return "text"
+	case "List<String>":
+		return "text[]"
+	case "List<int>":
+		return "bigint[]"
+	case "List<double>":
+		return "double precision[]"
+	case "List<bool>":
+		return "boolean[]"
+	default:
+		// Maps, nested lists and unknown values
+		return "jsonb"
+	}
+}
+
+// sqlIdentifier quotes name when Postgres would otherwise fold its case
+func sqlIdentifier(name string) string {
+	if plainIdentifier.MatchString(name) {
+		return name
+	}
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// migrationPath returns the migration creating table: the existing one,
+// reported by exists, or a new one named after version
+func (g *ModelGenerator) migrationPath(table, version string) (path string, exists bool, err error) {
+	entries, err := os.ReadDir(g.writer.GetFullPath(migrationsDir))
+	if err != nil && !os.IsNotExist(err) {
+		return "", false, err
+	}
+
+	// <14 digit version>_<table>.sql, so that tasks doesn't match user_tasks
+	name := regexp.MustCompile(`^\d{14}_` + regexp.QuoteMeta(table) + `\.sql$`)
+	for _, entry := range entries {
+		if !entry.IsDir() && name.MatchString(entry.Name()) {
+			return migrationsDir + "/" + entry.Name(), true, nil
+		}
+	}
+	return fmt.Sprintf("%s/%s_%s.sql", migrationsDir, version, table), false, nil
+}
@@ -2,20 +2,40 @@ package generator
 
 import (
 	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"fline-cli/internal/templates"
 	"fline-cli/internal/ui"
 	"fline-cli/internal/utils"
-	"strings"
 )
 
+// Data sources a model can be generated for
+const (
+	ModelSourceREST      = "rest"
+	ModelSourceFirestore = "firestore"
+	ModelSourceSupabase  = "supabase"
+)
+
+// ModelSources lists the model data sources in help order
+var ModelSources = []string{ModelSourceREST, ModelSourceFirestore, ModelSourceSupabase}
+
 // ModelGenerator generates models, services, repositories and BLoCs from JSON
 type ModelGenerator struct {
 	modelName   string
 	jsonData    map[string]interface{}
 	endpoint    string
+	source      string
+	collection  string
 	packageName string
 	writer      *utils.FileWriter
+	loader      *templates.Loader
 	naming      *utils.NamingHelper
 	logger      *ui.Logger
+	now         func() time.Time // Clock naming new migrations
+	migration   string           // Supabase migration, once chosen
+	migrated    bool             // The migration existed before, see migrationFile
 }
 
 // NewModelGenerator creates a new model generator
@@ -30,19 +50,91 @@ func NewModelGenerator(
 		modelName:   modelName,
 		jsonData:    jsonData,
 		endpoint:    endpoint,
+		source:      ModelSourceREST,
 		packageName: packageName,
 		writer:      writer,
+		loader:      templates.NewLoader(writer.BaseDir()),
 		naming:      utils.NewNamingHelper(modelName),
 		logger:      ui.NewLogger("model"),
+		now:         time.Now,
 	}
 }
 
+// SetSource selects the data source the service and repository use.
+// collection names the Firestore collection or Supabase table; empty means
+// the model name in plural.
+func (g *ModelGenerator) SetSource(source, collection string) error {
+	if source == "" {
+		source = ModelSourceREST
+	}
+	valid := false
+	for _, s := range ModelSources {
+		valid = valid || s == source
+	}
+	if !valid {
+		return fmt.Errorf("unknown model source %q (use %s)", source, strings.Join(ModelSources, ", "))
+	}
+
+	g.source = source
+	g.collection = collection
+
+	// The table name goes into SQL and the migration file name unquoted
+	if source == ModelSourceSupabase && !plainIdentifier.MatchString(g.Collection()) {
+		return fmt.Errorf("invalid table name %q: use lowercase letters, digits and underscores", g.Collection())
+	}
+	// The collection goes into firestore.rules, a test file name and Dart
+	if source == ModelSourceFirestore && !collectionID.MatchString(g.Collection()) {
+		return fmt.Errorf("invalid collection name %q: use letters, digits, underscores and hyphens", g.Collection())
+	}
+	return nil
+}
+
+// Collection returns the Firestore collection or Supabase table of the model
+func (g *ModelGenerator) Collection() string {
+	if g.collection != "" {
+		return g.collection
+	}
+	return g.naming.SnakeCase() + "s"
+}
+
+// Files returns the files Generate writes, relative to the project root
+func (g *ModelGenerator) Files() []string {
+	snake := g.naming.SnakeCase()
+	service := fmt.Sprintf("lib/network/service/%s_service.dart", snake)
+	if g.source != ModelSourceREST {
+		service = fmt.Sprintf("lib/network/service/%s_%s_service.dart", snake, g.source)
+	}
+
+	files := []string{
+		fmt.Sprintf("lib/model/%s.dart", snake),
+		service,
+		fmt.Sprintf("lib/repositories/%s_repository.dart", snake),
+		fmt.Sprintf("lib/state_management/bloc/%s/*", snake),
+	}
+	if g.source == ModelSourceFirestore {
+		files = append(files, firestoreRulesFile, fmt.Sprintf("%s/%s.test.js", firestoreTestsDir, g.Collection()))
+	}
+	if g.source == ModelSourceSupabase {
+		if migration, err := g.migrationFile(); err == nil && !g.migrated {
+			files = append(files, migration)
+		}
+	}
+	return files
+}
+
 // Generate generates all components
 func (g *ModelGenerator) Generate() error {
 	if err := g.generateModel(); err != nil {
 		return fmt.Errorf("failed to generate model: %w", err)
 	}
 
+	switch g.source {
+	case ModelSourceFirestore:
+		return g.generateFirestore()
+	case ModelSourceSupabase:
+		return g.generateSupabase()
+	}
+
 	if err := g.generateService(); err != nil {
 		return fmt.Errorf("failed to generate service: %w", err)
 	}
@@ -59,517 +151,158 @@ func (g *ModelGenerator) Generate() error {
 }
 
 func (g *ModelGenerator) generateModel() error {
-	fields := g.generateFields()
-	constructorParams := g.generateConstructorParams()
-	props := g.generateProps()
-
-	content := fmt.Sprintf(`import 'package:json_annotation/json_annotation.dart';
-import 'package:equatable/equatable.dart';
-
-part '%s.g.dart';
-
-@JsonSerializable()
-class %s extends Equatable {
-  %s
-
-  const %s({
-    %s
-  });
-
-  factory %s.fromJson(Map<String, dynamic> json) =>
-      _$%sFromJson(json);
-
-  Map<String, dynamic> toJson() => _$%sToJson(this);
-
-  @override
-  List<Object?> get props => [
-    %s
-  ];
-}
-`,
-		g.naming.SnakeCase(),
-		g.naming.PascalCase(),
-		fields,
-		g.naming.PascalCase(),
-		constructorParams,
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		props,
-	)
-
-	return g.writer.WriteFile(
-		fmt.Sprintf("lib/model/%s.dart", g.naming.SnakeCase()),
-		content,
-	)
+	return g.render("model/model.dart", fmt.Sprintf("lib/model/%s.dart", g.naming.SnakeCase()))
 }
 
 func (g *ModelGenerator) generateService() error {
-	content := fmt.Sprintf(`import 'package:dio/dio.dart';
-import 'package:retrofit/retrofit.dart';
-import 'package:%s/model/%s.dart';
-
-part '%s_service.g.dart';
-
-@RestApi()
-abstract class %sService {
-  factory %sService(Dio dio) = _%sService;
-
-  @GET('%s')
-  Future<List<%s>> getAll();
-
-  @GET('%s/{id}')
-  Future<%s> getById(@Path('id') String id);
-
-  @POST('%s')
-  Future<%s> create(@Body() %s %s);
-
-  @PUT('%s/{id}')
-  Future<%s> update(
-    @Path('id') String id,
-    @Body() %s %s,
-  );
-
-  @DELETE('%s/{id}')
-  Future<void> delete(@Path('id') String id);
-}
-`,
-		g.packageName,
-		g.naming.SnakeCase(),
-		g.naming.SnakeCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.endpoint,
-		g.naming.PascalCase(),
-		g.endpoint,
-		g.naming.PascalCase(),
-		g.endpoint,
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.CamelCase(),
-		g.endpoint,
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.CamelCase(),
-		g.endpoint,
-	)
-
-	return g.writer.WriteFile(
-		fmt.Sprintf("lib/network/service/%s_service.dart", g.naming.SnakeCase()),
-		content,
-	)
+	return g.render("model/service.dart",
+		fmt.Sprintf("lib/network/service/%s_service.dart", g.naming.SnakeCase()))
 }
 
 func (g *ModelGenerator) generateRepository() error {
-	content := fmt.Sprintf(`import 'package:logger/logger.dart';
-import 'package:%s/model/%s.dart';
-import 'package:%s/network/service/%s_service.dart';
-
-class %sRepository {
-  final %sService _service;
-  final Logger _logger;
-
-  %sRepository({
-    required %sService service,
-    required Logger logger,
-  })  : _service = service,
-        _logger = logger;
-
-  Future<List<%s>> getAll() async {
-    try {
-      return await _service.getAll();
-    } catch (e) {
-      _logger.e('Error fetching %ss', error: e);
-      rethrow;
-    }
-  }
-
-  Future<%s> getById(String id) async {
-    try {
-      return await _service.getById(id);
-    } catch (e) {
-      _logger.e('Error fetching %s', error: e);
-      rethrow;
-    }
-  }
-
-  Future<%s> create(%s %s) async {
-    try {
-      return await _service.create(%s);
-    } catch (e) {
-      _logger.e('Error creating %s', error: e);
-      rethrow;
-    }
-  }
-
-  Future<%s> update(String id, %s %s) async {
-    try {
-      return await _service.update(id, %s);
-    } catch (e) {
-      _logger.e('Error updating %s', error: e);
-      rethrow;
-    }
-  }
-
-  Future<void> delete(String id) async {
-    try {
-      await _service.delete(id);
-    } catch (e) {
-      _logger.e('Error deleting %s', error: e);
-      rethrow;
-    }
-  }
-}
-`,
-		g.packageName,
-		g.naming.SnakeCase(),
-		g.packageName,
-		g.naming.SnakeCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.CamelCase(),
-		g.naming.CamelCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.CamelCase(),
-		g.naming.CamelCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-	)
-
-	return g.writer.WriteFile(
-		fmt.Sprintf("lib/repositories/%s_repository.dart", g.naming.SnakeCase()),
-		content,
-	)
+	return g.render("model/repository.dart",
+		fmt.Sprintf("lib/repositories/%s_repository.dart", g.naming.SnakeCase()))
 }
 
-func (g *ModelGenerator) generateBloc() error {
-	// Generate bloc
-	blocContent := g.generateBlocFile()
-	if err := g.writer.WriteFile(
-		fmt.Sprintf("lib/state_management/bloc/%s/%s_bloc.dart",
-			g.naming.SnakeCase(), g.naming.SnakeCase()),
-		blocContent,
-	); err != nil {
-		return err
+// generateFirestore generates a collection-backed data source, a repository
+// and BLoC that can watch the collection, and the collection security rules
+func (g *ModelGenerator) generateFirestore() error {
+	// The id field holds the document ID
+	if typ := g.fieldType("id"); typ != "" && typ != "String" {
+		return fmt.Errorf("the id field holds the Firestore document ID, a string: make it a string or leave it out (got %s)", typ)
 	}
 
-	// Generate event
-	eventContent := g.generateEventFile()
-	if err := g.writer.WriteFile(
-		fmt.Sprintf("lib/state_management/bloc/%s/%s_event.dart",
-			g.naming.SnakeCase(), g.naming.SnakeCase()),
-		eventContent,
-	); err != nil {
-		return err
+	if err := g.render("model/firestore_service.dart",
+		fmt.Sprintf("lib/network/service/%s_firestore_service.dart", g.naming.SnakeCase())); err != nil {
+		return fmt.Errorf("failed to generate service: %w", err)
 	}
 
-	// Generate state
-	stateContent := g.generateStateFile()
-	return g.writer.WriteFile(
-		fmt.Sprintf("lib/state_management/bloc/%s/%s_state.dart",
-			g.naming.SnakeCase(), g.naming.SnakeCase()),
-		stateContent,
-	)
-}
-
-func (g *ModelGenerator) generateBlocFile() string {
-	return fmt.Sprintf(`import 'package:flutter_bloc/flutter_bloc.dart';
-import 'package:equatable/equatable.dart';
-import 'package:%s/repositories/%s_repository.dart';
-import 'package:%s/model/%s.dart';
-
-part '%s_event.dart';
-part '%s_state.dart';
-
-class %sBloc extends Bloc<%sEvent, %sState> {
-  final %sRepository _repository;
-
-  %sBloc({required %sRepository repository})
-      : _repository = repository,
-        super(%sInitial()) {
-    on<Fetch%ss>(_onFetch%ss);
-    on<Fetch%s>(_onFetch%s);
-    on<Create%s>(_onCreate%s);
-    on<Update%s>(_onUpdate%s);
-    on<Delete%s>(_onDelete%s);
-  }
-
-  Future<void> _onFetch%ss(
-    Fetch%ss event,
-    Emitter<%sState> emit,
-  ) async {
-    emit(%sLoading());
-    try {
-      final items = await _repository.getAll();
-      emit(%ssLoaded(items));
-    } catch (e) {
-      emit(%sError(e.toString()));
-    }
-  }
-
-  Future<void> _onFetch%s(
-    Fetch%s event,
-    Emitter<%sState> emit,
-  ) async {
-    emit(%sLoading());
-    try {
-      final item = await _repository.getById(event.id);
-      emit(%sLoaded(item));
-    } catch (e) {
-      emit(%sError(e.toString()));
-    }
-  }
-
-  Future<void> _onCreate%s(
-    Create%s event,
-    Emitter<%sState> emit,
-  ) async {
-    emit(%sLoading());
-    try {
-      await _repository.create(event.%s);
-      emit(%sCreated());
-    } catch (e) {
-      emit(%sError(e.toString()));
-    }
-  }
-
-  Future<void> _onUpdate%s(
-    Update%s event,
-    Emitter<%sState> emit,
-  ) async {
-    emit(%sLoading());
-    try {
-      await _repository.update(event.id, event.%s);
-      emit(%sUpdated());
-    } catch (e) {
-      emit(%sError(e.toString()));
-    }
-  }
-
-  Future<void> _onDelete%s(
-    Delete%s event,
-    Emitter<%sState> emit,
-  ) async {
-    emit(%sLoading());
-    try {
-      await _repository.delete(event.id);
-      emit(%sDeleted());
-    } catch (e) {
-      emit(%sError(e.toString()));
-    }
-  }
-}
-`,
-		g.packageName, g.naming.SnakeCase(),
-		g.packageName, g.naming.SnakeCase(),
-		g.naming.SnakeCase(), g.naming.SnakeCase(),
-		g.naming.PascalCase(), g.naming.PascalCase(), g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(), g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(), g.naming.PascalCase(),
-		g.naming.PascalCase(), g.naming.PascalCase(),
-		g.naming.PascalCase(), g.naming.PascalCase(),
-		g.naming.PascalCase(), g.naming.PascalCase(),
-		g.naming.PascalCase(), g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(), g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(), g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(), g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.CamelCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(), g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.CamelCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(), g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-	)
-}
-
-func (g *ModelGenerator) generateEventFile() string {
-	return fmt.Sprintf(`part of '%s_bloc.dart';
-
-abstract class %sEvent extends Equatable {
-  const %sEvent();
-
-  @override
-  List<Object> get props => [];
-}
-
-class Fetch%ss extends %sEvent {}
-
-class Fetch%s extends %sEvent {
-  final String id;
-
-  const Fetch%s({required this.id});
-
-  @override
-  List<Object> get props => [id];
-}
+	if err := g.render("model/firestore_repository.dart",
+		fmt.Sprintf("lib/repositories/%s_repository.dart", g.naming.SnakeCase())); err != nil {
+		return fmt.Errorf("failed to generate repository: %w", err)
+	}
 
-class Create%s extends %sEvent {
-  final %s %s;
+	if err := g.generateBloc(); err != nil {
+		return fmt.Errorf("failed to generate bloc: %w", err)
+	}
 
-  const Create%s({required this.%s});
+	if err := g.generateFirestoreRules(); err != nil {
+		return fmt.Errorf("failed to generate security rules: %w", err)
+	}
 
-  @override
-  List<Object> get props => [%s];
+	return nil
 }
 
-class Update%s extends %sEvent {
-  final String id;
-  final %s %s;
-
-  const Update%s({required this.id, required this.%s});
+// generateSupabase generates a table-backed data source, a repository and
+// BLoC that can watch the table, and the migration creating the table
+func (g *ModelGenerator) generateSupabase() error {
+	if err := g.render("model/supabase_service.dart",
+		fmt.Sprintf("lib/network/service/%s_supabase_service.dart", g.naming.SnakeCase())); err != nil {
+		return fmt.Errorf("failed to generate service: %w", err)
+	}
 
-  @override
-  List<Object> get props => [id, %s];
-}
+	if err := g.render("model/supabase_repository.dart",
+		fmt.Sprintf("lib/repositories/%s_repository.dart", g.naming.SnakeCase())); err != nil {
+		return fmt.Errorf("failed to generate repository: %w", err)
+	}
 
-class Delete%s extends %sEvent {
-  final String id;
+	if err := g.generateBloc(); err != nil {
+		return fmt.Errorf("failed to generate bloc: %w", err)
+	}
 
-  const Delete%s({required this.id});
+	migration, err := g.migrationFile()
+	if err != nil {
+		return fmt.Errorf("failed to generate migration: %w", err)
+	}
+	if g.migrated {
+		g.logger.Warning(fmt.Sprintf("%s already creates the %s table, add a new migration for any change", migration, g.Collection()))
+		return nil
+	}
+	if err := g.render("model/supabase_migration.sql", migration); err != nil {
+		return fmt.Errorf("failed to generate migration: %w", err)
+	}
 
-  @override
-  List<Object> get props => [id];
-}
-`,
-		g.naming.SnakeCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(), g.naming.PascalCase(),
-		g.naming.PascalCase(), g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(), g.naming.PascalCase(),
-		g.naming.PascalCase(), g.naming.CamelCase(),
-		g.naming.PascalCase(), g.naming.CamelCase(),
-		g.naming.CamelCase(),
-		g.naming.PascalCase(), g.naming.PascalCase(),
-		g.naming.PascalCase(), g.naming.CamelCase(),
-		g.naming.PascalCase(), g.naming.CamelCase(),
-		g.naming.CamelCase(),
-		g.naming.PascalCase(), g.naming.PascalCase(),
-		g.naming.PascalCase(),
-	)
+	return nil
 }
 
-func (g *ModelGenerator) generateStateFile() string {
-	return fmt.Sprintf(`part of '%s_bloc.dart';
-
-abstract class %sState extends Equatable {
-  const %sState();
-
-  @override
-  List<Object> get props => [];
+// migrationFile returns the migration creating the table of the model. An
+// existing one may already be applied, so it is never rewritten: g.migrated
+// tells Generate to leave it alone.
+func (g *ModelGenerator) migrationFile() (string, error) {
+	if g.migration == "" {
+		path, exists, err := g.migrationPath(g.Collection(), g.now().UTC().Format(migrationTimestamp))
+		if err != nil {
+			return "", err
+		}
+		g.migration = path
+		g.migrated = exists
+	}
+	return g.migration, nil
 }
 
-class %sInitial extends %sState {}
-
-class %sLoading extends %sState {}
-
-class %ssLoaded extends %sState {
-  final List<%s> items;
-
-  const %ssLoaded(this.items);
-
-  @override
-  List<Object> get props => [items];
-}
+func (g *ModelGenerator) generateBloc() error {
+	blocDir := fmt.Sprintf("lib/state_management/bloc/%s", g.naming.SnakeCase())
 
-class %sLoaded extends %sState {
-  final %s item;
+	// Generate bloc
+	if err := g.render("model/bloc.dart",
+		fmt.Sprintf("%s/%s_bloc.dart", blocDir, g.naming.SnakeCase())); err != nil {
+		return err
+	}
 
-  const %sLoaded(this.item);
+	// Generate event
+	if err := g.render("model/event.dart",
+		fmt.Sprintf("%s/%s_event.dart", blocDir, g.naming.SnakeCase())); err != nil {
+		return err
+	}
 
-  @override
-  List<Object> get props => [item];
+	// Generate state
+	return g.render("model/state.dart",
+		fmt.Sprintf("%s/%s_state.dart", blocDir, g.naming.SnakeCase()))
 }
 
-class %sCreated extends %sState {}
-
-class %sUpdated extends %sState {}
-
-class %sDeleted extends %sState {}
-
-class %sError extends %sState {
-  final String message;
-
-  const %sError(this.message);
-
-  @override
-  List<Object> get props => [message];
-}
-`,
-		g.naming.SnakeCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(), g.naming.PascalCase(),
-		g.naming.PascalCase(), g.naming.PascalCase(),
-		g.naming.PascalCase(), g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(), g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(),
-		g.naming.PascalCase(), g.naming.PascalCase(),
-		g.naming.PascalCase(), g.naming.PascalCase(),
-		g.naming.PascalCase(), g.naming.PascalCase(),
-		g.naming.PascalCase(), g.naming.PascalCase(),
-		g.naming.PascalCase(),
-	)
+func (g *ModelGenerator) render(name, path string) error {
+	content, err := g.loader.Render(name, g.data())
+	if err != nil {
+		return err
+	}
+	return g.writer.WriteFile(path, content)
 }
 
-func (g *ModelGenerator) generateFields() string {
-	var fields []string
+// data builds the template data, with fields sorted by name so that the
+// output is stable across runs
+func (g *ModelGenerator) data() templates.ModelData {
+	fields := make([]templates.Field, 0, len(g.jsonData))
 	for key, value := range g.jsonData {
-		fieldType := g.getFieldType(value)
-		fields = append(fields, fmt.Sprintf("final %s %s;", fieldType, key))
+		fields = append(fields, templates.Field{Name: key, Type: g.getFieldType(value)})
 	}
-	return strings.Join(fields, "\n  ")
-}
-
-func (g *ModelGenerator) generateConstructorParams() string {
-	var params []string
-	for key := range g.jsonData {
-		params = append(params, fmt.Sprintf("required this.%s", key))
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Name < fields[j].Name
+	})
+
+	owner := ownerField(fields)
+	data := templates.ModelData{
+		PackageName: g.packageName,
+		Name:        templates.NewNames(g.modelName),
+		Endpoint:    g.endpoint,
+		Collection:  g.Collection(),
+		Realtime:    g.source != ModelSourceREST,
+		OwnerField:  owner,
+		Fields:      fields,
+		Columns:     sqlColumns(fields, owner),
+		OwnerColumn: sqlIdentifier(owner),
 	}
-	return strings.Join(params, ",\n    ")
+	data.RulesChecks = rulesChecks(data.DocumentFields())
+	data.TestDocument = testDocument(data.DocumentFields(), owner)
+	return data
 }
 
-func (g *ModelGenerator) generateProps() string {
-	var props []string
-	for key := range g.jsonData {
-		props = append(props, key)
+// fieldType returns the Dart type of the field name, or "" without one
+func (g *ModelGenerator) fieldType(name string) string {
+	value, ok := g.jsonData[name]
+	if !ok {
+		return ""
 	}
-	return strings.Join(props, ",\n    ")
+	return g.getFieldType(value)
 }
 
 func (g *ModelGenerator) getFieldType(value interface{}) string {
@@ -0,0 +1,397 @@
+package generator
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"fline-cli/internal/dartedit"
+	"fline-cli/internal/templates"
+	"fline-cli/internal/ui"
+	"fline-cli/internal/utils"
+)
+
+// Kinds of screens generated for a model
+const (
+	ScreenKindList   = "list"
+	ScreenKindDetail = "detail"
+	ScreenKindForm   = "form"
+)
+
+// ScreenKinds lists the screen kinds in help order
+var ScreenKinds = []string{ScreenKindList, ScreenKindDetail, ScreenKindForm}
+
+// Files of the project the model screens are registered in
+const (
+	routerFile = "lib/routers/app_router.dart"
+	englishArb = "lib/l10n/app_en.arb"
+	italianArb = "lib/l10n/app_it.arb"
+)
+
+// fieldPattern matches the field declarations of a model, e.g.
+// "  final List<String> tags;"
+var fieldPattern = regexp.MustCompile(`(?m)^\s+final\s+([\w<>?, ]+?)\s+(\w+);\s*$`)
+
+// modelScreenFiles maps the files of each screen kind to their templates,
+// <name> standing for the model name in snake case
+var modelScreenFiles = map[string][]struct{ Path, Template string }{
+	ScreenKindList: {
+		{"lib/ui/<name>_list/<name>_list_page.dart", "model_screens/list_page.dart"},
+		{"lib/ui/<name>_list/widgets/<name>_list.dart", "model_screens/list.dart"},
+		{"lib/ui/<name>_list/widgets/<name>_list_tile.dart", "model_screens/list_tile.dart"},
+	},
+	ScreenKindDetail: {
+		{"lib/ui/<name>_detail/<name>_detail_page.dart", "model_screens/detail_page.dart"},
+		{"lib/ui/<name>_detail/widgets/<name>_details.dart", "model_screens/details.dart"},
+	},
+	ScreenKindForm: {
+		{"lib/ui/<name>_form/<name>_form_page.dart", "model_screens/form_page.dart"},
+		{"lib/ui/<name>_form/widgets/<name>_form.dart", "model_screens/form.dart"},
+	},
+}
+
+// sharedScreenFiles maps the widgets shared by the screens of every model
+// to their templates. They are only written when missing, so that they can
+// be customized.
+var sharedScreenFiles = map[string][]struct{ Path, Template string }{
+	ScreenKindList: {
+		{"lib/ui/shared/widgets/empty_view.dart", "screens/widgets/empty_view.dart"},
+		{"lib/ui/shared/widgets/error_view.dart", "screens/widgets/error_view.dart"},
+	},
+	ScreenKindDetail: {
+		{"lib/ui/shared/widgets/error_view.dart", "screens/widgets/error_view.dart"},
+	},
+	ScreenKindForm: {
+		{"lib/ui/shared/widgets/text_input_field.dart", "model_screens/text_input_field.dart"},
+		{"lib/ui/shared/widgets/number_input_field.dart", "model_screens/number_input_field.dart"},
+		{"lib/ui/shared/widgets/switch_field.dart", "model_screens/switch_field.dart"},
+	},
+}
+
+// ModelScreenGenerator generates list, detail and form screens on the BLoC
+// of a model generated by fline model
+type ModelScreenGenerator struct {
+	modelName   string
+	kinds       []string
+	packageName string
+	writer      *utils.FileWriter
+	loader      *templates.Loader
+	naming      *utils.NamingHelper
+	logger      *ui.Logger
+}
+
+// NewModelScreenGenerator creates a generator of all the screen kinds of a
+// model
+func NewModelScreenGenerator(modelName, packageName string, writer *utils.FileWriter) *ModelScreenGenerator {
+	return &ModelScreenGenerator{
+		modelName:   modelName,
+		kinds:       ScreenKinds,
+		packageName: packageName,
+		writer:      writer,
+		loader:      templates.NewLoader(writer.BaseDir()),
+		naming:      utils.NewNamingHelper(modelName),
+		logger:      ui.NewLogger("screen"),
+	}
+}
+
+// SetKinds selects the screen kinds to generate, in any order
+func (g *ModelScreenGenerator) SetKinds(kinds []string) error {
+	if len(kinds) == 0 {
+		return fmt.Errorf("no screen kind selected (use %s)", strings.Join(ScreenKinds, ", "))
+	}
+	for _, kind := range kinds {
+		if !slices.Contains(ScreenKinds, kind) {
+			return fmt.Errorf("unknown screen kind %q (use %s)", kind, strings.Join(ScreenKinds, ", "))
+		}
+	}
+
+	g.kinds = nil
+	for _, kind := range ScreenKinds {
+		if slices.Contains(kinds, kind) {
+			g.kinds = append(g.kinds, kind)
+		}
+	}
+	return nil
+}
+
+// Files returns the screen files Generate writes, relative to the project
+// root
+func (g *ModelScreenGenerator) Files() []string {
+	var files []string
+	for _, kind := range g.kinds {
+		for _, file := range modelScreenFiles[kind] {
+			files = append(files, g.path(file.Path))
+		}
+	}
+	return files
+}
+
+// Generate generates the screens, then adds their strings to the ARB files
+// and their routes to the router
+func (g *ModelScreenGenerator) Generate() error {
+	data, err := g.data()
+	if err != nil {
+		return err
+	}
+
+	for _, kind := range g.kinds {
+		for _, file := range sharedScreenFiles[kind] {
+			if g.writer.PathExists(file.Path) {
+				continue
+			}
+			if err := g.render(file.Template, file.Path, data); err != nil {
+				return err
+			}
+		}
+		for _, file := range modelScreenFiles[kind] {
+			if err := g.render(file.Template, g.path(file.Path), data); err != nil {
+				return err
+			}
+		}
+	}
+
+	list := templates.ModelScreenStrings(data)
+	if err := g.addStrings(englishArb, list, true); err != nil {
+		return err
+	}
+	if err := g.addStrings(italianArb, list, false); err != nil {
+		return err
+	}
+
+	return g.addRoutes()
+}
+
+// data reads the fields of the model and builds the template data. Pages
+// generated earlier are linked too.
+func (g *ModelScreenGenerator) data() (templates.ScreenData, error) {
+	snake := g.naming.SnakeCase()
+	modelFile := fmt.Sprintf("lib/model/%s.dart", snake)
+	blocFile := fmt.Sprintf("lib/state_management/bloc/%s/%s_bloc.dart", snake, snake)
+	for _, file := range []string{modelFile, blocFile} {
+		if !g.writer.PathExists(file) {
+			return templates.ScreenData{}, fmt.Errorf("%s not found, generate the model first with fline model %s", file, snake)
+		}
+	}
+
+	source, err := g.writer.ReadFile(modelFile)
+	if err != nil {
+		return templates.ScreenData{}, err
+	}
+	fields := screenFields(source)
+	id := slices.IndexFunc(fields, func(f templates.ScreenField) bool { return f.Name == "id" })
+	if id < 0 {
+		return templates.ScreenData{}, fmt.Errorf("%s has no id field", modelFile)
+	}
+
+	data := templates.ScreenData{
+		PackageName: g.packageName,
+		Name:        templates.NewNames(g.modelName),
+		Label:       humanize(g.modelName),
+		Fields:      fields,
+		Title:       screenTitle(fields),
+		ID:          ".id",
+	}
+	if fields[id].Display != "item.id" {
+		data.ID = ".id.toString()" // Not a String
+	}
+
+	exists := func(kind string) bool {
+		return slices.Contains(g.kinds, kind) || g.writer.PathExists(g.path(modelScreenFiles[kind][0].Path))
+	}
+	data.List = exists(ScreenKindList)
+	data.Detail = exists(ScreenKindDetail)
+	data.Form = exists(ScreenKindForm)
+	return data, nil
+}
+
+// addStrings adds the strings missing from an ARB file of the project
+func (g *ModelScreenGenerator) addStrings(path string, list []templates.LocalizedString, english bool) error {
+	if !g.writer.PathExists(path) {
+		g.logger.Warning(fmt.Sprintf("%s not found, add the strings of the screens by hand", path))
+		return nil
+	}
+	source, err := g.writer.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	updated, changed, err := addArbStrings(source, list, english)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	if !changed {
+		return nil
+	}
+	return g.writer.WriteFile(path, updated)
+}
+
+// addRoutes registers the generated pages in the router, behind the auth
+// guard when the router has one
+func (g *ModelScreenGenerator) addRoutes() error {
+	type route struct{ Page, Code string }
+	guards := ""
+	source := ""
+	if g.writer.PathExists(routerFile) {
+		var err error
+		if source, err = g.writer.ReadFile(routerFile); err != nil {
+			return err
+		}
+		if strings.Contains(source, "_authGuard") {
+			guards = ", guards: [_authGuard]"
+		}
+	}
+
+	var routes []route
+	for _, kind := range g.kinds {
+		page := g.naming.PascalCase() + utils.NewNamingHelper(kind).PascalCase() + "Route.page"
+		routes = append(routes, route{page, fmt.Sprintf("AutoRoute(page: %s%s)", page, guards)})
+	}
+
+	if source == "" {
+		g.logger.Warning(fmt.Sprintf("%s not found, add these routes by hand:", routerFile))
+		for _, r := range routes {
+			g.logger.Info(r.Code + ",")
+		}
+		return nil
+	}
+
+	changed := false
+	for _, r := range routes {
+		updated, added, err := dartedit.AddRoute(source, r.Page, r.Code)
+		if err != nil {
+			return fmt.Errorf("%s: %w", routerFile, err)
+		}
+		source = updated
+		changed = changed || added
+	}
+	if !changed {
+		return nil
+	}
+	return g.writer.WriteFile(routerFile, source)
+}
+
+// path replaces <name> in a screen file path
+func (g *ModelScreenGenerator) path(path string) string {
+	return strings.ReplaceAll(path, "<name>", g.naming.SnakeCase())
+}
+
+func (g *ModelScreenGenerator) render(name, path string, data templates.ScreenData) error {
+	content, err := g.loader.Render(name, data)
+	if err != nil {
+		return err
+	}
+	return g.writer.WriteFile(path, content)
+}
+
+// screenFields reads the fields of a model source and decides how the
+// screens show and edit each of them
+func screenFields(source string) []templates.ScreenField {
+	var fields []templates.ScreenField
+	for _, match := range fieldPattern.FindAllStringSubmatch(source, -1) {
+		fields = append(fields, screenField(match[2], strings.TrimSpace(match[1])))
+	}
+	return fields
+}
+
+// screenField builds a field of Dart type typ. Only strings, numbers and
+// booleans can be edited: the form keeps the value of the other fields, or
+// starts them empty.
+func screenField(name, typ string) templates.ScreenField {
+	field := templates.ScreenField{
+		Name:    name,
+		Label:   humanize(name),
+		Key:     utils.NewNamingHelper(name).PascalCase(),
+		Display: "item." + name + ".toString()",
+	}
+
+	nullable := strings.HasSuffix(typ, "?") || typ == "dynamic"
+	if !nullable && name != "id" {
+		field.Input = map[string]string{
+			"String": "text",
+			"int":    "int",
+			"double": "double",
+			"bool":   "bool",
+		}[typ]
+	}
+
+	switch {
+	case nullable:
+		field.Display = "item." + name + "?.toString() ?? ''"
+	case typ == "String":
+		field.Display = "item." + name
+	case typ == "bool":
+		field.Display = "item." + name + " ? l10n.yesLabel : l10n.noLabel"
+	case strings.HasPrefix(typ, "List<"):
+		field.Display = "item." + name + ".join(', ')"
+	}
+
+	controller := "_" + name + "Controller.text.trim()"
+	switch field.Input {
+	case "text":
+		field.Value = controller
+	case "int":
+		field.Value = "int.parse(" + controller + ")"
+	case "double":
+		field.Value = "double.parse(" + controller + ")"
+	case "bool":
+		field.Value = "_" + name + "Key.currentState?.value ?? false"
+	default:
+		field.Value = "edited?." + name + emptyValue(typ, nullable)
+	}
+	return field
+}
+
+// emptyValue returns the default of a field of type typ in a new model, as
+// an if-null expression
+func emptyValue(typ string, nullable bool) string {
+	switch {
+	case nullable:
+		return ""
+	case typ == "String":
+		return " ?? ''"
+	case typ == "int":
+		return " ?? 0"
+	case typ == "double":
+		return " ?? 0.0"
+	case typ == "bool":
+		return " ?? false"
+	case strings.HasPrefix(typ, "List<"):
+		return " ?? const []"
+	case strings.HasPrefix(typ, "Map<"):
+		return " ?? const {}"
+	}
+	return "!" // No default for other classes, only edits work
+}
+
+// screenTitle returns the Dart String naming an item in the list: its name
+// or title when it has one, its first text field otherwise, its ID at last
+func screenTitle(fields []templates.ScreenField) string {
+	for _, name := range []string{"name", "title", "label", "email"} {
+		for _, f := range fields {
+			if f.Name == name && f.Input == "text" {
+				return f.Display
+			}
+		}
+	}
+	for _, f := range fields {
+		if f.Input == "text" {
+			return f.Display
+		}
+	}
+	for _, f := range fields {
+		if f.Name == "id" {
+			return f.Display
+		}
+	}
+	return "item.toString()"
+}
+
+// humanize turns a Dart or JSON name into words, e.g. firstName into
+// First name
+func humanize(name string) string {
+	words := strings.ReplaceAll(utils.NewNamingHelper(name).SnakeCase(), "_", " ")
+	if words == "" {
+		return name
+	}
+	return strings.ToUpper(words[:1]) + words[1:]
+}
@@ -0,0 +1,146 @@
+package generator
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"fline-cli/internal/utils"
+)
+
+// writeScreenProject writes the files of a project the model screens are
+// registered in, and the user model
+func writeScreenProject(t *testing.T, dir string) {
+	t.Helper()
+
+	files := map[string]string{
+		"lib/l10n/app_en.arb": `{
+    "appTitle": "demo_app",
+    "@appTitle": {
+        "description": "The title of the application"
+    },
+    "retryButton": "Try again"
+}
+`,
+		"lib/l10n/app_it.arb": `{
+    "appTitle": "demo_app"
+}
+`,
+		"lib/routers/app_router.dart": `import 'package:auto_route/auto_route.dart';
+import 'package:demo_app/routers/app_router.gr.dart';
+import 'package:demo_app/routers/auth_guard.dart';
+
+@AutoRouterConfig(
+  replaceInRouteName: 'Page,Route',
+)
+class AppRouter extends RootStackRouter {
+  final _authGuard = AuthGuard();
+
+  @override
+  List<AutoRoute> get routes => [
+        AutoRoute(page: HomeRoute.page, initial: true),
+      ];
+}
+`,
+	}
+	for path, content := range files {
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(dir, path)), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, path), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var data map[string]interface{}
+	err := json.Unmarshal([]byte(`{
+		"id": 1,
+		"firstName": "John",
+		"age": 30,
+		"rating": 4.5,
+		"active": true,
+		"tags": ["a"],
+		"address": {"city": "Rome"},
+		"deletedAt": null
+	}`), &data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := NewModelGenerator("user", data, "/api/users", "demo_app", utils.NewFileWriter(dir)).Generate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestModelScreenGeneratorGenerate(t *testing.T) {
+	isolateTemplates(t)
+
+	dir := t.TempDir()
+	writeScreenProject(t, dir)
+
+	gen := NewModelScreenGenerator("user", "demo_app", utils.NewFileWriter(dir))
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	assertGolden(t, dir, "model_screens")
+
+	// Model and field names aren't copied to Italian, they fall back to English
+	italian, _ := os.ReadFile(filepath.Join(dir, italianArb))
+	for _, key := range []string{"userListTitle", "userDetailTitle", "userCreateTitle", "userFirstNameLabel"} {
+		if strings.Contains(string(italian), key) {
+			t.Errorf("%s copied to %s", key, italianArb)
+		}
+	}
+
+	// A second run adds neither strings nor routes
+	router, _ := os.ReadFile(filepath.Join(dir, routerFile))
+	arb, _ := os.ReadFile(filepath.Join(dir, englishArb))
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate() again error = %v", err)
+	}
+	if again, _ := os.ReadFile(filepath.Join(dir, routerFile)); string(again) != string(router) {
+		t.Errorf("routes added twice:\n%s", again)
+	}
+	if again, _ := os.ReadFile(filepath.Join(dir, englishArb)); string(again) != string(arb) {
+		t.Errorf("strings added twice:\n%s", again)
+	}
+}
+
+func TestModelScreenGeneratorKinds(t *testing.T) {
+	isolateTemplates(t)
+
+	dir := t.TempDir()
+	writeScreenProject(t, dir)
+
+	gen := NewModelScreenGenerator("user", "demo_app", utils.NewFileWriter(dir))
+	if err := gen.SetKinds([]string{"grid"}); err == nil {
+		t.Fatal("expected an error for an unknown kind")
+	}
+	if err := gen.SetKinds([]string{ScreenKindList}); err != nil {
+		t.Fatal(err)
+	}
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	// Without the detail and form screens, the list links to neither
+	tile, err := os.ReadFile(filepath.Join(dir, "lib/ui/user_list/widgets/user_list_tile.dart"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(tile), "UserDetailRoute") {
+		t.Errorf("list tile opens a missing detail screen:\n%s", tile)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "lib/ui/user_form")); err == nil {
+		t.Error("form screen generated")
+	}
+}
+
+func TestModelScreenGeneratorNeedsModel(t *testing.T) {
+	gen := NewModelScreenGenerator("user", "demo_app", utils.NewFileWriter(t.TempDir()))
+	if err := gen.Generate(); err == nil {
+		t.Fatal("expected an error without the user model")
+	}
+}
@@ -0,0 +1,206 @@
+package generator
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"fline-cli/internal/utils"
+)
+
+func TestModelGeneratorGenerate(t *testing.T) {
+	isolateTemplates(t)
+
+	var data map[string]interface{}
+	err := json.Unmarshal([]byte(`{
+		"id": 1,
+		"name": "John",
+		"rating": 4.5,
+		"active": true,
+		"tags": ["a", "b"],
+		"address": {"city": "Rome"},
+		"deletedAt": null
+	}`), &data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	gen := NewModelGenerator("user_profile", data, "/api/user-profiles", "demo_app", utils.NewFileWriter(dir))
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	assertGolden(t, dir, "model")
+}
+
+func TestModelGeneratorFirestore(t *testing.T) {
+	isolateTemplates(t)
+
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(`{"id": "t1", "title": "Buy milk", "done": false, "ownerId": "u1"}`), &data); err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	gen := NewModelGenerator("task", data, "", "demo_app", utils.NewFileWriter(dir))
+	if err := gen.SetSource(ModelSourceFirestore, ""); err != nil {
+		t.Fatal(err)
+	}
+	if got := gen.Collection(); got != "tasks" {
+		t.Errorf("Collection() = %q", got)
+	}
+
+	if err := gen.Generate(); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	assertGolden(t, dir, "model_firestore")
+
+	// Items created with a generated ID are found again by that ID: it is
+	// read from the snapshot, and never written into the document
+	files := readTree(t, dir)
+	assertContains(t, files["lib/network/service/task_firestore_service.dart"],
+		"Task.fromJson(\n                {...snapshot.data()!, 'id': snapshot.id},",
+		"task.toJson()..remove('id')",
+		"final reference = await _collection.add(task);\n    return reference.id;",
+		"return _collection.doc(id).set(task);")
+	assertContains(t, files["firestore.rules"], "data.keys().hasOnly(['done', 'ownerId', 'title'])")
+}
+
+func TestModelGeneratorFirestoreNumericID(t *testing.T) {
+	data := map[string]interface{}{"id": 1.0, "title": "Buy milk"}
+	gen := NewModelGenerator("task", data, "", "demo_app", utils.NewFileWriter(t.TempDir()))
+	if err := gen.SetSource(ModelSourceFirestore, ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := gen.Generate(); err == nil {
+		t.Fatal("expected an error for a numeric id, document IDs are strings")
+	}
+}
+
+func TestModelGeneratorUnknownSource(t *testing.T) {
+	gen := NewModelGenerator("task", nil, "", "demo_app", utils.NewFileWriter(t.TempDir()))
+	if err := gen.SetSource("graphql", ""); err == nil {
+		t.Fatal("expected an error for an unknown source")
+	}
+}
+
+func TestModelGeneratorSupabase(t *testing.T) {
+	isolateTemplates(t)
+
+	var data map[string]interface{}
+	err := json.Unmarshal([]byte(`{"id": 1, "title": "Buy milk", "done": false, "userId": "u1", "tags": ["home"], "meta": {}}`), &data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	newGenerator := func(now time.Time) *ModelGenerator {
+		gen := NewModelGenerator("task", data, "", "demo_app", utils.NewFileWriter(dir))
+		gen.now = func() time.Time { return now }
+		if err := gen.SetSource(ModelSourceSupabase, ""); err != nil {
+			t.Fatal(err)
+		}
+		return gen
+	}
+
+	if err := newGenerator(time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)).Generate(); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	assertGolden(t, dir, "model_supabase")
+
+	// Generating the model again keeps the migration, which may be applied
+	// already, and adds no other
+	migration := filepath.Join(dir, "supabase/migrations/20260301093000_tasks.sql")
+	if err := os.WriteFile(migration, []byte("-- applied\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	gen := newGenerator(time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC))
+	if err := gen.Generate(); err != nil {
+		t.Fatal(err)
+	}
+	if content, _ := os.ReadFile(migration); string(content) != "-- applied\n" {
+		t.Errorf("existing migration rewritten:\n%s", content)
+	}
+	entries, _ := os.ReadDir(filepath.Join(dir, "supabase/migrations"))
+	if len(entries) != 1 {
+		t.Errorf("migrations = %d, want 1", len(entries))
+	}
+	for _, file := range gen.Files() {
+		if strings.HasPrefix(file, "supabase/") {
+			t.Errorf("Files() lists the existing migration %s", file)
+		}
+	}
+}
+
+func TestModelGeneratorMigrationPath(t *testing.T) {
+	dir := t.TempDir()
+	writer := utils.NewFileWriter(dir)
+	for _, path := range []string{
+		"supabase/migrations/20260101000000_user_tasks.sql",
+		"supabase/migrations/20260101000000_tasks_archive.sql",
+		"supabase/migrations/backup_tasks.sql",
+		"supabase/migrations/20260102000000_orders.sql",
+	} {
+		if err := writer.WriteFile(path, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		table      string
+		want       string
+		wantExists bool
+	}{
+		{"tasks", "supabase/migrations/20260301093000_tasks.sql", false},
+		{"orders", "supabase/migrations/20260102000000_orders.sql", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.table, func(t *testing.T) {
+			gen := NewModelGenerator("task", nil, "", "demo_app", writer)
+			got, exists, err := gen.migrationPath(tt.table, "20260301093000")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want || exists != tt.wantExists {
+				t.Errorf("migrationPath() = %q, %v, want %q, %v", got, exists, tt.want, tt.wantExists)
+			}
+		})
+	}
+}
+
+func TestModelGeneratorInvalidTable(t *testing.T) {
+	for _, table := range []string{"Tasks", "user-tasks", "tasks.sql", "../tasks", "1tasks"} {
+		gen := NewModelGenerator("task", nil, "", "demo_app", utils.NewFileWriter(t.TempDir()))
+		if err := gen.SetSource(ModelSourceSupabase, table); err == nil {
+			t.Errorf("SetSource(supabase, %q) accepted an invalid table", table)
+		}
+	}
+
+	// Firestore collections aren't SQL tables
+	gen := NewModelGenerator("task", nil, "", "demo_app", utils.NewFileWriter(t.TempDir()))
+	if err := gen.SetSource(ModelSourceFirestore, "userTasks"); err != nil {
+		t.Errorf("SetSource(firestore) error = %v", err)
+	}
+}
+
+func TestModelGeneratorInvalidCollection(t *testing.T) {
+	for _, collection := range []string{"../../escaped", "tasks/archive", "tasks'", "my tasks", ".."} {
+		gen := NewModelGenerator("task", nil, "", "demo_app", utils.NewFileWriter(t.TempDir()))
+		if err := gen.SetSource(ModelSourceFirestore, collection); err == nil {
+			t.Errorf("SetSource(firestore, %q) accepted an invalid collection", collection)
+		}
+	}
+
+	for _, collection := range []string{"userTasks", "user_tasks", "user-tasks"} {
+		gen := NewModelGenerator("task", nil, "", "demo_app", utils.NewFileWriter(t.TempDir()))
+		if err := gen.SetSource(ModelSourceFirestore, collection); err != nil {
+			t.Errorf("SetSource(firestore, %q) error = %v", collection, err)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package generator
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"fline-cli/internal/config"
+	"fline-cli/internal/templates"
+	"fline-cli/internal/ui"
+	"fline-cli/internal/utils"
+)
+
+// Files of the notification subsystem shared by every provider
+const (
+	notificationServiceFile = "lib/network/service/notification_service.dart"
+	notificationRouterFile  = "lib/routers/notification_router.dart"
+)
+
+// notificationServiceFiles maps each provider to the files implementing its
+// NotificationService. FCM shows foreground messages with local
+// notifications, so it needs both.
+var notificationServiceFiles = map[string][]string{
+	config.NotificationsFCM: {
+		"lib/network/service/local_notification_service.dart",
+		"lib/network/service/fcm_notification_service.dart",
+	},
+	config.NotificationsOneSignal: {"lib/network/service/onesignal_notification_service.dart"},
+	config.NotificationsLocal:     {"lib/network/service/local_notification_service.dart"},
+}
+
+// localNotificationProvider registers the local notifications used by the
+// local and FCM providers
+var localNotificationProvider = diProvider{Type: "LocalNotificationService", Code: `Provider<LocalNotificationService>(
+  create: (context) => LocalNotificationService(
+    logger: context.read<Logger>(),
+  ),
+),`}
+
+// notificationRouterProvider starts the notification subsystem with the app
+var notificationRouterProvider = diProvider{Type: "NotificationRouter", Code: `Provider<NotificationRouter>(
+  lazy: false,
+  create: (context) => NotificationRouter(
+    service: context.read<NotificationService>(),
+    router: router,
+    logger: context.read<Logger>(),
+  )..start(),
+  dispose: (_, notificationRouter) => notificationRouter.dispose(),
+),`}
+
+// NotificationGenerator sets up the notification provider selected in the
+// config: the NotificationService abstraction, its implementation, the
+// router opening the page of tapped notifications and their DI
+// registration
+type NotificationGenerator struct {
+	config *config.ProjectConfig
+	writer *utils.FileWriter
+	loader *templates.Loader
+	logger *ui.Logger
+}
+
+// NewNotificationGenerator creates a new notification generator
+func NewNotificationGenerator(cfg *config.ProjectConfig, writer *utils.FileWriter) *NotificationGenerator {
+	return &NotificationGenerator{
+		config: cfg,
+		writer: writer,
+		loader: templates.NewLoader(writer.BaseDir()),
+		logger: ui.NewLogger("notifications"),
+	}
+}
+
+// Files returns the Dart files written for the selected provider
+func (g *NotificationGenerator) Files() []string {
+	files := []string{notificationServiceFile}
+	files = append(files, notificationServiceFiles[g.config.NotificationService]...)
+	return append(files, notificationRouterFile)
+}
+
+// Generate writes the notification subsystem. The packages are expected to
+// be in the pubspec already.
+func (g *NotificationGenerator) Generate() error {
+	service := g.config.NotificationService
+	if _, ok := notificationServiceFiles[service]; !ok {
+		return fmt.Errorf("unknown notification provider %q", service)
+	}
+
+	for _, path := range g.Files() {
+		if err := g.render(path); err != nil {
+			return err
+		}
+	}
+
+	if err := g.registerProviders(); err != nil {
+		return err
+	}
+
+	// The FCM background handler must be registered before runApp
+	if service == config.NotificationsFCM {
+		err := wireMain(g.writer, g.logger,
+			[]string{
+				"package:firebase_messaging/firebase_messaging.dart",
+				packageImport(g.config.ProjectName, "lib/network/service/fcm_notification_service.dart"),
+			},
+			[]string{"FirebaseMessaging.onBackgroundMessage(firebaseMessagingBackgroundHandler);"})
+		if err != nil {
+			return err
+		}
+	}
+
+	g.logger.Success(fmt.Sprintf("Notifications configured (%s)", service))
+	g.logger.Info("Don't forget to:")
+	for i, step := range g.setupSteps() {
+		g.logger.Info(fmt.Sprintf("%d. %s", i+1, step))
+	}
+	return nil
+}
+
+// setupSteps returns the native setup the selected provider still needs
+func (g *NotificationGenerator) setupSteps() []string {
+	var steps []string
+	switch g.config.NotificationService {
+	case config.NotificationsOneSignal:
+		steps = append(steps, "Pass your OneSignal app ID with --dart-define=ONESIGNAL_APP_ID=<app id>")
+	default:
+		steps = append(steps, "Enable core library desugaring in android/app/build.gradle (required by flutter_local_notifications)")
+	}
+	if g.config.NotificationService != config.NotificationsLocal {
+		steps = append(steps, "Add the Push Notifications capability to the iOS Runner target in Xcode")
+	}
+	return append(steps, `Send a "route" in the notification data to open a page when it is tapped`)
+}
+
+func (g *NotificationGenerator) registerProviders() error {
+	name := g.config.ProjectName
+	imports := []string{packageImport(name, "lib/app.dart")}
+	for _, path := range g.Files() {
+		imports = append(imports, packageImport(name, path))
+	}
+
+	var providers []diProvider
+	switch g.config.NotificationService {
+	case config.NotificationsFCM:
+		imports = append(imports, "package:firebase_messaging/firebase_messaging.dart")
+		providers = []diProvider{
+			{Type: "FirebaseMessaging", Code: `Provider<FirebaseMessaging>(create: (_) => FirebaseMessaging.instance),`},
+			localNotificationProvider,
+			{Type: "NotificationService", Code: `Provider<NotificationService>(
+  create: (context) => FcmNotificationService(
+    messaging: context.read<FirebaseMessaging>(),
+    local: context.read<LocalNotificationService>(),
+    logger: context.read<Logger>(),
+  ),
+),`},
+		}
+	case config.NotificationsOneSignal:
+		providers = []diProvider{
+			{Type: "NotificationService", Code: `Provider<NotificationService>(
+  create: (context) => OneSignalNotificationService(
+    appId: const String.fromEnvironment('ONESIGNAL_APP_ID'),
+    logger: context.read<Logger>(),
+  ),
+),`},
+		}
+	case config.NotificationsLocal:
+		providers = []diProvider{
+			localNotificationProvider,
+			{Type: "NotificationService", Code: `Provider<NotificationService>(
+  create: (context) => context.read<LocalNotificationService>(),
+),`},
+		}
+	}
+	providers = append(providers, notificationRouterProvider)
+
+	return registerProviders(g.writer, g.logger, imports, providers)
+}
+
+// render writes a file of the subsystem from the template named after it
+func (g *NotificationGenerator) render(path string) error {
+	content, err := g.loader.Render("notifications/"+filepath.Base(path), templates.NewProjectData(g.config))
+	if err != nil {
+		return err
+	}
+	return g.writer.WriteFile(path, content)
+}
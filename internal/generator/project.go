@@ -1,35 +1,73 @@
 package generator
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
 
 	"fline-cli/internal/config"
+	"fline-cli/internal/pubspec"
 	"fline-cli/internal/templates"
 	"fline-cli/internal/ui"
 	"fline-cli/internal/utils"
 )
 
+// Default timeouts of the toolchain steps, overridden by
+// config.ProjectConfig.StepTimeout
+const (
+	createTimeout      = 5 * time.Minute
+	pubGetTimeout      = 5 * time.Minute
+	genL10nTimeout     = 2 * time.Minute
+	buildRunnerTimeout = 15 * time.Minute
+)
+
 // ProjectGenerator generates a complete Flutter project
 type ProjectGenerator struct {
-	config *config.ProjectConfig
-	logger *ui.Logger
-	writer *utils.FileWriter
-	flutter *utils.FlutterCLI
+	config     *config.ProjectConfig
+	logger     *ui.Logger
+	writer     *utils.FileWriter
+	loader     *templates.Loader
+	flutter    utils.Flutter
+	newFlutter utils.FlutterFactory
 }
 
 // NewProjectGenerator creates a new project generator
 func NewProjectGenerator(cfg *config.ProjectConfig) *ProjectGenerator {
 	return &ProjectGenerator{
-		config: cfg,
-		logger: ui.NewLogger("generator"),
+		config:     cfg,
+		logger:     ui.NewLogger("generator"),
+		newFlutter: utils.DefaultFlutterFactory,
 	}
 }
 
-// Generate generates the complete project
-func (g *ProjectGenerator) Generate() error {
-	g.logger.Step(1, 8, "Creating Flutter project...")
-	if err := g.createFlutterProject(); err != nil {
-		return fmt.Errorf("failed to create Flutter project: %w", err)
+// SetFlutterFactory replaces the Flutter toolchain, e.g. with a fake in tests
+func (g *ProjectGenerator) SetFlutterFactory(factory utils.FlutterFactory) {
+	g.newFlutter = factory
+}
+
+// Generate generates the complete project.
+//
+// The project is built inside a hidden staging directory next to the final
+// location and only moved into place once every step has succeeded, so a
+// failure never leaves a half-built project behind. Cancelling ctx stops the
+// running toolchain step and rolls back.
+func (g *ProjectGenerator) Generate(ctx context.Context) (err error) {
+	g.config.Normalize()
+	if err := g.config.ValidateNotifications(); err != nil {
+		return err
+	}
+	if err := g.config.ValidateSocialSignIn(); err != nil {
+		return err
+	}
+	if err := g.config.ValidateRest(); err != nil {
+		return err
+	}
+	if err := g.config.ValidateScreens(); err != nil {
+		return err
 	}
 
 	// Build project path
@@ -40,9 +78,44 @@ func (g *ProjectGenerator) Generate() error {
 		projectPath = g.config.ProjectName
 	}
 
+	stagingDir, err := os.MkdirTemp(g.config.TargetDirectory, "."+g.config.ProjectName+"-fline-")
+	if err != nil {
+		return fmt.Errorf("failed to create staging directory: %w", err)
+	}
+	stagedPath := filepath.Join(stagingDir, g.config.ProjectName)
+
+	defer func() {
+		if err == nil {
+			return
+		}
+		if g.config.KeepOnFailure {
+			g.logger.Warning("Partially generated project kept at " + stagedPath)
+			return
+		}
+		if rmErr := os.RemoveAll(stagingDir); rmErr != nil {
+			g.logger.Warning(fmt.Sprintf("Failed to clean up %s: %s", stagingDir, rmErr))
+		}
+	}()
+
+	// With --force, start from a copy of the existing project so that
+	// flutter create --overwrite behaves as it would in place
+	if g.config.Force {
+		if _, statErr := os.Stat(projectPath); statErr == nil {
+			if err := utils.CopyDir(projectPath, stagedPath); err != nil {
+				return fmt.Errorf("failed to stage existing project: %w", err)
+			}
+		}
+	}
+
+	g.logger.Step(1, 8, "Creating Flutter project...")
+	if err := g.createFlutterProject(ctx, stagingDir); err != nil {
+		return fmt.Errorf("failed to create Flutter project: %w", err)
+	}
+
 	// Initialize helpers
-	g.writer = utils.NewFileWriter(projectPath)
-	g.flutter = utils.NewFlutterCLI(projectPath)
+	g.writer = utils.NewFileWriter(stagedPath)
+	g.loader = templates.NewLoader(stagedPath)
+	g.flutter = g.newFlutter(stagedPath, nil)
 
 	g.logger.Step(2, 8, "Updating pubspec.yaml...")
 	if err := g.updatePubspec(); err != nil {
@@ -59,9 +132,16 @@ func (g *ProjectGenerator) Generate() error {
 		return fmt.Errorf("failed to generate core files: %w", err)
 	}
 
-	g.logger.Step(5, 8, "Installing dependencies...")
-	if err := g.flutter.PubGet(); err != nil {
-		return fmt.Errorf("failed to install dependencies: %w", err)
+	if g.config.SkipPubGet {
+		g.logger.Step(5, 8, "Skipping dependency installation (--skip-pub-get)")
+	} else {
+		g.logger.Step(5, 8, "Installing dependencies...")
+		err := g.runFlutter(ctx, stagedPath, "flutter pub get", pubGetTimeout, func(ctx context.Context, flutter utils.Flutter) error {
+			return flutter.PubGet(ctx, g.config.Offline)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to install dependencies: %w", err)
+		}
 	}
 
 	// Firebase integration
@@ -80,6 +160,22 @@ func (g *ProjectGenerator) Generate() error {
 		}
 	}
 
+	// Custom REST API
+	if g.config.UseRest {
+		g.logger.Step(6, 8, "Setting up REST API...")
+		if err := g.setupRest(); err != nil {
+			return fmt.Errorf("failed to setup REST API: %w", err)
+		}
+	}
+
+	// FCM notifications come with the Firebase messaging module
+	if g.config.EnableNotifications && g.config.NotificationService != config.NotificationsFCM {
+		g.logger.Step(6, 8, "Setting up notifications...")
+		if err := g.setupNotifications(); err != nil {
+			return fmt.Errorf("failed to setup notifications: %w", err)
+		}
+	}
+
 	// Generate models
 	if len(g.config.Models) > 0 {
 		g.logger.Step(7, 8, fmt.Sprintf("Generating %d models...", len(g.config.Models)))
@@ -94,62 +190,214 @@ func (g *ProjectGenerator) Generate() error {
 		return fmt.Errorf("failed to generate screens: %w", err)
 	}
 
-	g.logger.Step(8, 8, "Running code generation...")
+	pending := templates.PendingSetupSteps(g.config)
+	if len(pending) > 0 {
+		// Code generation needs resolved packages, so it is deferred
+		// together with pub get
+		g.logger.Step(8, 8, "Skipping code generation, writing Makefile...")
+		if err := g.writeMakefile(); err != nil {
+			return fmt.Errorf("failed to write Makefile: %w", err)
+		}
+	} else {
+		g.logger.Step(8, 8, "Running code generation...")
+
+		// Generate localizations
+		err := g.runFlutter(ctx, stagedPath, "flutter gen-l10n", genL10nTimeout, func(ctx context.Context, flutter utils.Flutter) error {
+			return flutter.GenL10n(ctx)
+		})
+		if err != nil {
+			return fmt.Errorf("localization generation failed: %w\nPlease run manually: flutter gen-l10n", err)
+		}
 
-	// Generate localizations
-	if err := g.flutter.GenL10n(); err != nil {
-		return fmt.Errorf("localization generation failed: %w\nPlease run manually: flutter gen-l10n", err)
+		// Run build_runner for auto_route
+		err = g.runFlutter(ctx, stagedPath, "build_runner build", buildRunnerTimeout, func(ctx context.Context, flutter utils.Flutter) error {
+			return flutter.BuildRunnerBuild(ctx)
+		})
+		if err != nil {
+			return fmt.Errorf("build runner failed: %w\nPlease run manually: flutter pub run build_runner build --delete-conflicting-outputs", err)
+		}
 	}
 
-	// Run build_runner for auto_route
-	if err := g.flutter.BuildRunnerBuild(); err != nil {
-		return fmt.Errorf("build runner failed: %w\nPlease run manually: flutter pub run build_runner build --delete-conflicting-outputs", err)
+	if err := g.commit(stagingDir, stagedPath, projectPath); err != nil {
+		return fmt.Errorf("failed to move project into place: %w", err)
 	}
 
 	g.logger.Success("Project created successfully!")
 	g.logger.NewLine()
 	g.logger.Info("Next steps:")
 	g.logger.Info("1. cd " + g.config.ProjectName)
-	g.logger.Info("2. flutter run")
+	if len(pending) > 0 {
+		g.logger.Info("2. make setup (runs the skipped steps):")
+		for _, step := range pending {
+			g.logger.Info("     " + step.Command)
+		}
+		g.logger.Info("3. flutter run")
+	} else {
+		g.logger.Info("2. flutter run")
+	}
 	g.logger.NewLine()
 	g.logger.Info("To add models later, use: fline model")
 
 	return nil
 }
 
-func (g *ProjectGenerator) createFlutterProject() error {
-	flutter := utils.NewFlutterCLI(g.config.TargetDirectory)
-	return flutter.Create(
-		g.config.ProjectName,
-		g.config.OrganizationName,
-		g.config.Force,
-	)
+// commit moves the staged project to its final location, replacing the
+// previous directory (already copied into staging) when --force is set
+func (g *ProjectGenerator) commit(stagingDir, stagedPath, projectPath string) error {
+	backupPath := filepath.Join(stagingDir, "previous")
+
+	_, statErr := os.Stat(projectPath)
+	hadPrevious := statErr == nil
+	if hadPrevious {
+		if err := os.Rename(projectPath, backupPath); err != nil {
+			return err
+		}
+	}
+
+	if err := os.Rename(stagedPath, projectPath); err != nil {
+		if hadPrevious {
+			if restoreErr := os.Rename(backupPath, projectPath); restoreErr != nil {
+				return fmt.Errorf("%w (previous project left at %s)", err, backupPath)
+			}
+		}
+		return err
+	}
+
+	if err := os.RemoveAll(stagingDir); err != nil {
+		g.logger.Warning(fmt.Sprintf("Failed to clean up %s: %s", stagingDir, err))
+	}
+
+	return nil
+}
+
+func (g *ProjectGenerator) createFlutterProject(ctx context.Context, dir string) error {
+	return g.runFlutter(ctx, dir, "flutter create", createTimeout, func(ctx context.Context, flutter utils.Flutter) error {
+		return flutter.Create(
+			ctx,
+			g.config.ProjectName,
+			g.config.OrganizationName,
+			g.config.Force,
+		)
+	})
+}
+
+// runFlutter runs a toolchain step in dir, streaming its output to a task
+// view and stopping it after timeout (or config.StepTimeout when set)
+func (g *ProjectGenerator) runFlutter(ctx context.Context, dir, title string, timeout time.Duration, fn func(ctx context.Context, flutter utils.Flutter) error) error {
+	if g.config.StepTimeout > 0 {
+		timeout = time.Duration(g.config.StepTimeout)
+	}
+
+	return ui.RunTask(ctx, title, func(ctx context.Context, out io.Writer) error {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		err := fn(ctx, g.newFlutter(dir, out))
+		if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("%s did not finish within %s (raise it with --step-timeout): %w", title, timeout, err)
+		}
+		return err
+	})
 }
 
+// updatePubspec adds the Pine dependencies to the pubspec.yaml written by
+// flutter create, keeping its comments and layout
 func (g *ProjectGenerator) updatePubspec() error {
-	content := templates.GeneratePubspec(g.config)
-	return g.writer.WriteFile("pubspec.yaml", content)
+	path := g.writer.GetFullPath("pubspec.yaml")
+	spec, err := pubspec.Load(path)
+	if err != nil {
+		return err
+	}
+
+	if _, err := spec.Set([]string{"description"}, templates.NewProjectData(g.config).Description); err != nil {
+		return err
+	}
+
+	if err := addDependencies(spec, g.config); err != nil {
+		return err
+	}
+
+	// gen-l10n needs the generate flag
+	for _, key := range []string{"uses-material-design", "generate"} {
+		if _, err := spec.Set([]string{"flutter", key}, true); err != nil {
+			return err
+		}
+	}
+
+	return spec.Save(path)
+}
+
+// addDependencies adds the dependencies required by cfg to spec, updating
+// the constraints of those already there
+func addDependencies(spec *pubspec.Pubspec, cfg *config.ProjectConfig) error {
+	deps, devDeps := templates.PubspecDependencies(cfg)
+	sections := map[string][]templates.Dependency{
+		pubspec.SectionDependencies:    deps,
+		pubspec.SectionDevDependencies: devDeps,
+	}
+
+	for _, section := range []string{pubspec.SectionDependencies, pubspec.SectionDevDependencies} {
+		for _, dep := range sections[section] {
+			_, err := spec.SetDependency(section, pubspec.Dependency{
+				Name:    dep.Name,
+				Version: dep.Version,
+				SDK:     dep.SDK,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to add %s: %w", dep.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// writeMakefile writes the skipped toolchain steps to the project Makefile
+func (g *ProjectGenerator) writeMakefile() error {
+	content, err := g.loader.Render("project/Makefile", templates.NewProjectData(g.config))
+	if err != nil {
+		return err
+	}
+	return g.writer.WriteFile("Makefile", content)
+}
+
+// ProjectFolders is the Pine folder layout of a project
+var ProjectFolders = []string{
+	"lib/di",
+	"lib/l10n",
+	"lib/mappers",
+	"lib/model",
+	"lib/network/interceptor",
+	"lib/network/service",
+	"lib/repositories",
+	"lib/routers",
+	"lib/state_management/bloc",
+	"lib/state_management/cubit",
+	"lib/state_management/provider",
+	"lib/ui",
+	"lib/utils",
+	"lib/theme",
+}
+
+// CoreFiles maps the core files of a project to the template rendering them
+var CoreFiles = map[string]string{
+	"lib/main.dart":                   "project/main.dart",
+	"lib/app.dart":                    "project/app.dart",
+	"lib/di/dependency_injector.dart": "project/di/dependency_injector.dart",
+	"lib/di/blocs.dart":               "project/di/blocs.dart",
+	"lib/di/mappers.dart":             "project/di/mappers.dart",
+	"lib/di/providers.dart":           "project/di/providers.dart",
+	"lib/di/repositories.dart":        "project/di/repositories.dart",
+	"lib/theme/light_theme.dart":      "project/theme/light_theme.dart",
+	"lib/routers/app_router.dart":     "project/routers/app_router.dart",
+	"lib/l10n/app_en.arb":             "project/l10n/app_en.arb",
+	"lib/l10n/app_it.arb":             "project/l10n/app_it.arb",
+	"l10n.yaml":                       "project/l10n.yaml",
+	"CLAUDE.md":                       "project/CLAUDE.md",
 }
 
 func (g *ProjectGenerator) createFolderStructure() error {
-	folders := []string{
-		"lib/di",
-		"lib/l10n",
-		"lib/mappers",
-		"lib/model",
-		"lib/network/interceptor",
-		"lib/network/service",
-		"lib/repositories",
-		"lib/routers",
-		"lib/state_management/bloc",
-		"lib/state_management/cubit",
-		"lib/state_management/provider",
-		"lib/ui",
-		"lib/utils",
-		"lib/theme",
-	}
-
-	for _, folder := range folders {
+	for _, folder := range ProjectFolders {
 		if err := g.writer.EnsureDir(folder); err != nil {
 			return err
 		}
@@ -159,23 +407,12 @@ func (g *ProjectGenerator) createFolderStructure() error {
 }
 
 func (g *ProjectGenerator) generateCoreFiles() error {
-	files := map[string]string{
-		"lib/main.dart":                       templates.GenerateMain(),
-		"lib/app.dart":                        templates.GenerateApp(g.config.ProjectName),
-		"lib/di/dependency_injector.dart":     templates.GenerateDependencyInjector(),
-		"lib/di/blocs.dart":                   templates.GenerateBlocs(),
-		"lib/di/mappers.dart":                 templates.GenerateMappers(),
-		"lib/di/providers.dart":               templates.GenerateProviders(),
-		"lib/di/repositories.dart":            templates.GenerateRepositories(),
-		"lib/theme/light_theme.dart":          templates.GenerateLightTheme(),
-		"lib/routers/app_router.dart":         templates.GenerateAppRouter(g.config.ProjectName),
-		"lib/l10n/app_en.arb":                 templates.GenerateL10n("en", g.config.ProjectName),
-		"lib/l10n/app_it.arb":                 templates.GenerateL10n("it", g.config.ProjectName),
-		"l10n.yaml":                           templates.GenerateL10nYaml(),
-		"CLAUDE.md":                           templates.GenerateClaudeMd(g.config.AppContext),
-	}
-
-	for path, content := range files {
+	data := templates.NewProjectData(g.config)
+	for path, name := range CoreFiles {
+		content, err := g.loader.Render(name, data)
+		if err != nil {
+			return err
+		}
 		if err := g.writer.WriteFile(path, content); err != nil {
 			return err
 		}
@@ -185,12 +422,22 @@ func (g *ProjectGenerator) generateCoreFiles() error {
 }
 
 func (g *ProjectGenerator) setupFirebase() error {
-	gen := NewFirebaseGenerator(g.config, g.writer, g.flutter)
+	gen := NewFirebaseGenerator(g.config, g.writer)
 	return gen.Generate()
 }
 
 func (g *ProjectGenerator) setupSupabase() error {
-	gen := NewSupabaseGenerator(g.config, g.writer, g.flutter)
+	gen := NewSupabaseGenerator(g.config, g.writer)
+	return gen.Generate()
+}
+
+func (g *ProjectGenerator) setupRest() error {
+	gen := NewRestGenerator(g.config, g.writer)
+	return gen.Generate()
+}
+
+func (g *ProjectGenerator) setupNotifications() error {
+	gen := NewNotificationGenerator(g.config, g.writer)
 	return gen.Generate()
 }
 
@@ -203,6 +450,9 @@ func (g *ProjectGenerator) generateModels() error {
 			g.config.ProjectName,
 			g.writer,
 		)
+		if err := gen.SetSource(model.Source, model.Collection); err != nil {
+			return fmt.Errorf("failed to generate model %s: %w", model.Name, err)
+		}
 
 		if err := gen.Generate(); err != nil {
 			return fmt.Errorf("failed to generate model %s: %w", model.Name, err)
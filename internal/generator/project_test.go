@@ -0,0 +1,292 @@
+package generator
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"fline-cli/internal/config"
+	"fline-cli/internal/fluttertest"
+)
+
+func newTestConfig(target string) *config.ProjectConfig {
+	cfg := config.DefaultProjectConfig()
+	cfg.ProjectName = "demo_app"
+	cfg.OrganizationName = "com.example"
+	cfg.TargetDirectory = target
+	return cfg
+}
+
+func TestProjectGeneratorGenerate(t *testing.T) {
+	tests := []struct {
+		name      string
+		configure func(cfg *config.ProjectConfig)
+	}{
+		{
+			name:      "default",
+			configure: func(cfg *config.ProjectConfig) {},
+		},
+		{
+			name: "firebase",
+			configure: func(cfg *config.ProjectConfig) {
+				cfg.UseFirebase = true
+				cfg.EnableNotifications = true
+				cfg.NotificationService = config.NotificationsFCM
+				cfg.Firebase.EnableStorage = true
+				cfg.Firebase.EnableAnalytics = true
+				cfg.Firebase.EnableCrashlytics = true
+				cfg.GenerateProfileScreen = true
+				cfg.GenerateSettingsScreen = true
+				cfg.SocialSignIn = []string{config.SocialGoogle, config.SocialApple}
+				cfg.AppContext = "Demo app: Login → Home → Profile"
+				cfg.Models = []config.ModelConfig{{
+					Name:     "user",
+					JSONData: map[string]interface{}{"id": 1.0, "name": "John"},
+					Endpoint: "/api/users",
+				}}
+			},
+		},
+		{
+			name: "supabase",
+			configure: func(cfg *config.ProjectConfig) {
+				cfg.UseSupabase = true
+				cfg.Supabase.ProjectURL = "https://demo.supabase.co"
+				cfg.Supabase.AnonKey = "demo-anon-key"
+				cfg.Supabase.EnableStorage = true
+				cfg.Description = "A Supabase demo"
+				cfg.EnableNotifications = true
+				cfg.NotificationService = config.NotificationsOneSignal
+				cfg.SocialSignIn = []string{config.SocialGitHub}
+			},
+		},
+		{
+			name: "shell",
+			configure: func(cfg *config.ProjectConfig) {
+				cfg.GenerateLoginScreen = false
+				cfg.GenerateProfileScreen = true
+				cfg.GenerateOnboardingScreen = true
+				cfg.GenerateShellScreen = true
+				cfg.GenerateStateViews = true
+			},
+		},
+		{
+			name: "rest",
+			configure: func(cfg *config.ProjectConfig) {
+				cfg.UseRest = true
+				cfg.Rest.BaseURL = "https://api.example.com"
+				cfg.Rest.RefreshPath = "/auth/token"
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isolateTemplates(t)
+
+			target := t.TempDir()
+			cfg := newTestConfig(target)
+			tt.configure(cfg)
+
+			fake := fluttertest.New()
+			gen := NewProjectGenerator(cfg)
+			gen.SetFlutterFactory(fake.Factory())
+
+			if err := gen.Generate(context.Background()); err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			assertGolden(t, filepath.Join(target, "demo_app"), filepath.Join("project", tt.name))
+			assertOnlyEntries(t, target, "demo_app")
+
+			want := []string{
+				"flutter create --org com.example --project-name demo_app --no-pub demo_app",
+				"flutter pub get",
+				"flutter gen-l10n",
+				"flutter pub run build_runner build --delete-conflicting-outputs",
+			}
+			if got := fake.Commands(); !reflect.DeepEqual(got, want) {
+				t.Errorf("commands = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestProjectGeneratorDefersSkippedSteps(t *testing.T) {
+	tests := []struct {
+		name      string
+		configure func(cfg *config.ProjectConfig)
+		commands  []string
+		makefile  []string
+	}{
+		{
+			name:      "skip codegen offline",
+			configure: func(cfg *config.ProjectConfig) { cfg.SkipCodegen = true; cfg.Offline = true },
+			commands:  []string{"flutter pub get --offline"},
+			makefile:  []string{"setup: l10n codegen", "@flutter gen-l10n"},
+		},
+		{
+			name:      "skip pub get",
+			configure: func(cfg *config.ProjectConfig) { cfg.SkipPubGet = true },
+			commands:  nil,
+			makefile:  []string{"setup: deps l10n codegen", "@flutter pub get\n", "@flutter pub run build_runner build"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isolateTemplates(t)
+
+			target := t.TempDir()
+			cfg := newTestConfig(target)
+			tt.configure(cfg)
+
+			fake := fluttertest.New()
+			gen := NewProjectGenerator(cfg)
+			gen.SetFlutterFactory(fake.Factory())
+
+			if err := gen.Generate(context.Background()); err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			want := append([]string{"flutter create --org com.example --project-name demo_app --no-pub demo_app"}, tt.commands...)
+			if got := fake.Commands(); !reflect.DeepEqual(got, want) {
+				t.Errorf("commands = %q, want %q", got, want)
+			}
+
+			makefile, err := os.ReadFile(filepath.Join(target, "demo_app", "Makefile"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, want := range tt.makefile {
+				if !strings.Contains(string(makefile), want) {
+					t.Errorf("Makefile does not contain %q:\n%s", want, makefile)
+				}
+			}
+		})
+	}
+}
+
+func TestProjectGeneratorRollsBackOnFailure(t *testing.T) {
+	isolateTemplates(t)
+
+	target := t.TempDir()
+	cfg := newTestConfig(target)
+
+	fake := fluttertest.New()
+	fake.FailOn("pub run build_runner build --delete-conflicting-outputs", errors.New("build failed"))
+
+	gen := NewProjectGenerator(cfg)
+	gen.SetFlutterFactory(fake.Factory())
+
+	if err := gen.Generate(context.Background()); err == nil {
+		t.Fatal("Generate() succeeded, want error")
+	}
+
+	assertOnlyEntries(t, target)
+}
+
+func TestProjectGeneratorRollsBackWhenCancelled(t *testing.T) {
+	isolateTemplates(t)
+
+	target := t.TempDir()
+	cfg := newTestConfig(target)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	gen := NewProjectGenerator(cfg)
+	gen.SetFlutterFactory(fluttertest.New().Factory())
+
+	if err := gen.Generate(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Generate() error = %v, want context.Canceled", err)
+	}
+
+	assertOnlyEntries(t, target)
+}
+
+func TestProjectGeneratorKeepOnFailure(t *testing.T) {
+	isolateTemplates(t)
+
+	target := t.TempDir()
+	cfg := newTestConfig(target)
+	cfg.KeepOnFailure = true
+
+	fake := fluttertest.New()
+	fake.FailOn("pub get", errors.New("offline"))
+
+	gen := NewProjectGenerator(cfg)
+	gen.SetFlutterFactory(fake.Factory())
+
+	if err := gen.Generate(context.Background()); err == nil {
+		t.Fatal("Generate() succeeded, want error")
+	}
+
+	entries, err := os.ReadDir(target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || !strings.HasPrefix(entries[0].Name(), ".demo_app-fline-") {
+		t.Fatalf("target entries = %v, want a single staging directory", entries)
+	}
+
+	staged := filepath.Join(target, entries[0].Name(), "demo_app", "pubspec.yaml")
+	if _, err := os.Stat(staged); err != nil {
+		t.Errorf("staged project not kept: %v", err)
+	}
+}
+
+func TestProjectGeneratorForceKeepsExistingFiles(t *testing.T) {
+	isolateTemplates(t)
+
+	target := t.TempDir()
+	cfg := newTestConfig(target)
+	cfg.Force = true
+
+	notes := filepath.Join(target, "demo_app", "NOTES.md")
+	if err := os.MkdirAll(filepath.Dir(notes), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(notes, []byte("keep me"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	fake := fluttertest.New()
+	gen := NewProjectGenerator(cfg)
+	gen.SetFlutterFactory(fake.Factory())
+
+	if err := gen.Generate(context.Background()); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	content, err := os.ReadFile(notes)
+	if err != nil || string(content) != "keep me" {
+		t.Errorf("existing file not preserved: %q, %v", content, err)
+	}
+	assertOnlyEntries(t, target, "demo_app")
+}
+
+// assertOnlyEntries checks that dir contains exactly the given entries,
+// i.e. that no staging directory was left behind
+func assertOnlyEntries(t *testing.T, dir string, want ...string) {
+	t.Helper()
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := []string{}
+	for _, e := range entries {
+		got = append(got, e.Name())
+	}
+	if want == nil {
+		want = []string{}
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("entries of %s = %v, want %v", dir, got, want)
+	}
+}
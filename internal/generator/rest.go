@@ -0,0 +1,85 @@
+package generator
+
+import (
+	"fmt"
+
+	"fline-cli/internal/config"
+	"fline-cli/internal/templates"
+	"fline-cli/internal/ui"
+	"fline-cli/internal/utils"
+)
+
+// refreshInterceptorFile renews the JWT tokens of the REST API, only
+// projects using it for auth have it
+const refreshInterceptorFile = "lib/network/interceptor/refresh_token_interceptor.dart"
+
+// restFiles maps the files of the REST API integration to their templates.
+// TokenStore and the Dio interceptor are registered by the providers
+// template, before the Dio provider reading them.
+var restFiles = []struct{ Path, Template string }{
+	{"lib/utils/api_config.dart", "rest/api_config.dart"},
+	{"lib/model/auth_tokens.dart", "rest/auth_tokens.dart"},
+	{"lib/network/service/auth_service.dart", "rest/auth_service.dart"},
+	{refreshInterceptorFile, "rest/refresh_token_interceptor.dart"},
+	{tokenStoreFile, "auth/token_store.dart"},
+}
+
+// RestGenerator handles the custom REST API integration: a Retrofit
+// AuthService, the TokenStore keeping its JWT tokens and the interceptor
+// renewing them
+type RestGenerator struct {
+	config *config.ProjectConfig
+	writer *utils.FileWriter
+	loader *templates.Loader
+	logger *ui.Logger
+}
+
+// NewRestGenerator creates a new REST API generator
+func NewRestGenerator(cfg *config.ProjectConfig, writer *utils.FileWriter) *RestGenerator {
+	return &RestGenerator{
+		config: cfg,
+		writer: writer,
+		loader: templates.NewLoader(writer.BaseDir()),
+		logger: ui.NewLogger("rest"),
+	}
+}
+
+// Generate sets up the REST API with the endpoints of the config
+func (g *RestGenerator) Generate() error {
+	g.logger.Info("Setting up REST API integration...")
+
+	data := templates.NewProjectData(g.config)
+	for _, file := range restFiles {
+		content, err := g.loader.Render(file.Template, data)
+		if err != nil {
+			return err
+		}
+		if err := g.writer.WriteFile(file.Path, content); err != nil {
+			return err
+		}
+	}
+
+	err := registerProviders(g.writer, g.logger,
+		[]string{packageImport(g.config.ProjectName, "lib/network/service/auth_service.dart")},
+		[]diProvider{
+			{Type: "AuthService", Code: `Provider<AuthService>(
+  create: (context) => AuthService(context.read<Dio>()),
+),`},
+		})
+	if err != nil {
+		return err
+	}
+
+	rest := g.config.Rest
+	g.logger.Success("REST API integration configured")
+	g.logger.Info("Don't forget to:")
+	step := 1
+	if rest.BaseURL == "" {
+		g.logger.Info("1. Pass the API URL with --dart-define=API_BASE_URL=<url>")
+		step = 2
+	}
+	g.logger.Info(fmt.Sprintf("%d. Check that %s, %s and %s answer with {\"access_token\", \"refresh_token\"}",
+		step, rest.LoginPath, rest.RegisterPath, rest.RefreshPath))
+
+	return nil
+}
@@ -2,6 +2,7 @@ package generator
 
 import (
 	"fline-cli/internal/config"
+	"fline-cli/internal/templates"
 	"fline-cli/internal/ui"
 	"fline-cli/internal/utils"
 )
@@ -10,6 +11,7 @@ import (
 type ScreenGenerator struct {
 	config *config.ProjectConfig
 	writer *utils.FileWriter
+	loader *templates.Loader
 	logger *ui.Logger
 }
 
@@ -18,6 +20,7 @@ func NewScreenGenerator(cfg *config.ProjectConfig, writer *utils.FileWriter) *Sc
 	return &ScreenGenerator{
 		config: cfg,
 		writer: writer,
+		loader: templates.NewLoader(writer.BaseDir()),
 		logger: ui.NewLogger("screens"),
 	}
 }
@@ -28,7 +31,7 @@ func (g *ScreenGenerator) Generate() error {
 		if err := g.generateLoginScreen(); err != nil {
 			return err
 		}
-		g.logger.Success("Generated Login screen")
+		g.logger.Success("Generated Login, Sign Up and Forgot Password screens")
 	}
 
 	if g.config.GenerateHomeScreen {
@@ -52,675 +55,180 @@ func (g *ScreenGenerator) Generate() error {
 		g.logger.Success("Generated Settings screen")
 	}
 
-	return nil
-}
-
-func (g *ScreenGenerator) generateLoginScreen() error {
-	content := `import 'package:flutter/material.dart';
-import 'package:auto_route/auto_route.dart';
-
-@RoutePage()
-class LoginPage extends StatefulWidget {
-  const LoginPage({super.key});
-
-  @override
-  State<LoginPage> createState() => _LoginPageState();
-}
-
-class _LoginPageState extends State<LoginPage> {
-  final _formKey = GlobalKey<FormState>();
-  final _emailController = TextEditingController();
-  final _passwordController = TextEditingController();
-  bool _isLoading = false;
-
-  @override
-  void dispose() {
-    _emailController.dispose();
-    _passwordController.dispose();
-    super.dispose();
-  }
-
-  Future<void> _handleLogin() async {
-    if (_formKey.currentState?.validate() ?? false) {
-      setState(() => _isLoading = true);
-
-      try {
-        // TODO: Implement login logic
-        await Future.delayed(const Duration(seconds: 1));
-
-        if (mounted) {
-          // Navigate to home
-          // context.router.replace(const HomeRoute());
-        }
-      } catch (e) {
-        if (mounted) {
-          ScaffoldMessenger.of(context).showSnackBar(
-            SnackBar(content: Text('Login failed: $e')),
-          );
-        }
-      } finally {
-        if (mounted) {
-          setState(() => _isLoading = false);
-        }
-      }
-    }
-  }
-
-  @override
-  Widget build(BuildContext context) {
-    return Scaffold(
-      body: SafeArea(
-        child: Center(
-          child: SingleChildScrollView(
-            padding: const EdgeInsets.all(24.0),
-            child: Form(
-              key: _formKey,
-              child: Column(
-                mainAxisAlignment: MainAxisAlignment.center,
-                crossAxisAlignment: CrossAxisAlignment.stretch,
-                children: [
-                  // Logo or App Name
-                  Icon(
-                    Icons.lock_outline,
-                    size: 80,
-                    color: Theme.of(context).primaryColor,
-                  ),
-                  const SizedBox(height: 48),
-
-                  // Title
-                  Text(
-                    'Welcome Back',
-                    style: Theme.of(context).textTheme.headlineMedium?.copyWith(
-                          fontWeight: FontWeight.bold,
-                        ),
-                    textAlign: TextAlign.center,
-                  ),
-                  const SizedBox(height: 8),
-                  Text(
-                    'Sign in to continue',
-                    style: Theme.of(context).textTheme.bodyMedium?.copyWith(
-                          color: Colors.grey,
-                        ),
-                    textAlign: TextAlign.center,
-                  ),
-                  const SizedBox(height: 48),
-
-                  // Email field
-                  TextFormField(
-                    controller: _emailController,
-                    keyboardType: TextInputType.emailAddress,
-                    decoration: const InputDecoration(
-                      labelText: 'Email',
-                      hintText: 'Enter your email',
-                      prefixIcon: Icon(Icons.email_outlined),
-                      border: OutlineInputBorder(),
-                    ),
-                    validator: (value) {
-                      if (value == null || value.isEmpty) {
-                        return 'Please enter your email';
-                      }
-                      if (!value.contains('@')) {
-                        return 'Please enter a valid email';
-                      }
-                      return null;
-                    },
-                  ),
-                  const SizedBox(height: 16),
-
-                  // Password field
-                  TextFormField(
-                    controller: _passwordController,
-                    obscureText: true,
-                    decoration: const InputDecoration(
-                      labelText: 'Password',
-                      hintText: 'Enter your password',
-                      prefixIcon: Icon(Icons.lock_outlined),
-                      border: OutlineInputBorder(),
-                    ),
-                    validator: (value) {
-                      if (value == null || value.isEmpty) {
-                        return 'Please enter your password';
-                      }
-                      if (value.length < 6) {
-                        return 'Password must be at least 6 characters';
-                      }
-                      return null;
-                    },
-                  ),
-                  const SizedBox(height: 24),
+	if g.config.GenerateSplashScreen {
+		if err := g.renderAll(splashScreenFiles); err != nil {
+			return err
+		}
+		g.logger.Success("Generated Splash screen")
+	}
 
-                  // Login button
-                  FilledButton(
-                    onPressed: _isLoading ? null : _handleLogin,
-                    child: Padding(
-                      padding: const EdgeInsets.symmetric(vertical: 16.0),
-                      child: _isLoading
-                          ? const SizedBox(
-                              height: 20,
-                              width: 20,
-                              child: CircularProgressIndicator(strokeWidth: 2),
-                            )
-                          : const Text('Sign In'),
-                    ),
-                  ),
-                  const SizedBox(height: 16),
+	if g.config.GenerateOnboardingScreen {
+		if err := g.generateOnboardingScreen(); err != nil {
+			return err
+		}
+		g.logger.Success("Generated Onboarding screen")
+	}
 
-                  // Forgot password
-                  TextButton(
-                    onPressed: () {
-                      // TODO: Navigate to forgot password
-                    },
-                    child: const Text('Forgot Password?'),
-                  ),
+	if g.config.GenerateShellScreen {
+		if err := g.renderAll(shellScreenFiles); err != nil {
+			return err
+		}
+		g.logger.Success("Generated bottom navigation shell")
+	}
 
-                  const SizedBox(height: 24),
+	if g.config.GenerateStateViews {
+		if err := g.renderAll(stateViewFiles); err != nil {
+			return err
+		}
+		g.logger.Success("Generated empty and error state widgets")
+	}
 
-                  // Sign up link
-                  Row(
-                    mainAxisAlignment: MainAxisAlignment.center,
-                    children: [
-                      const Text("Don't have an account? "),
-                      TextButton(
-                        onPressed: () {
-                          // TODO: Navigate to sign up
-                        },
-                        child: const Text('Sign Up'),
-                      ),
-                    ],
-                  ),
-                ],
-              ),
-            ),
-          ),
-        ),
-      ),
-    );
-  }
+	return nil
 }
-`
 
-	return g.writer.WriteFile("lib/ui/login/login_page.dart", content)
+// authScreenFiles maps the files of the login, sign up and password reset
+// screens to their templates
+var authScreenFiles = []struct{ Path, Template string }{
+	{"lib/ui/login/login_page.dart", "screens/login_page.dart"},
+	{"lib/ui/login/widgets/login_form.dart", "screens/login_form.dart"},
+	{"lib/ui/sign_up/sign_up_page.dart", "screens/sign_up_page.dart"},
+	{"lib/ui/sign_up/widgets/sign_up_form.dart", "screens/sign_up_form.dart"},
+	{"lib/ui/forgot_password/forgot_password_page.dart", "screens/forgot_password_page.dart"},
+	{"lib/ui/forgot_password/widgets/forgot_password_form.dart", "screens/forgot_password_form.dart"},
+	{"lib/ui/shared/widgets/auth_header.dart", "screens/widgets/auth_header.dart"},
+	{"lib/ui/shared/widgets/auth_submit_button.dart", "screens/widgets/auth_submit_button.dart"},
+	{"lib/ui/shared/widgets/email_field.dart", "screens/widgets/email_field.dart"},
+	{"lib/ui/shared/widgets/password_field.dart", "screens/widgets/password_field.dart"},
 }
 
-func (g *ScreenGenerator) generateHomeScreen() error {
-	content := `import 'package:flutter/material.dart';
-import 'package:auto_route/auto_route.dart';
-
-@RoutePage()
-class HomePage extends StatelessWidget {
-  const HomePage({super.key});
-
-  @override
-  Widget build(BuildContext context) {
-    return Scaffold(
-      appBar: AppBar(
-        title: const Text('Home'),
-        actions: [
-          IconButton(
-            icon: const Icon(Icons.notifications_outlined),
-            onPressed: () {
-              // TODO: Show notifications
-            },
-          ),
-          IconButton(
-            icon: const Icon(Icons.person_outline),
-            onPressed: () {
-              // TODO: Navigate to profile
-            },
-          ),
-        ],
-      ),
-      drawer: Drawer(
-        child: ListView(
-          padding: EdgeInsets.zero,
-          children: [
-            DrawerHeader(
-              decoration: BoxDecoration(
-                color: Theme.of(context).primaryColor,
-              ),
-              child: const Column(
-                crossAxisAlignment: CrossAxisAlignment.start,
-                mainAxisAlignment: MainAxisAlignment.end,
-                children: [
-                  CircleAvatar(
-                    radius: 32,
-                    child: Icon(Icons.person, size: 32),
-                  ),
-                  SizedBox(height: 8),
-                  Text(
-                    'User Name',
-                    style: TextStyle(
-                      color: Colors.white,
-                      fontSize: 18,
-                      fontWeight: FontWeight.bold,
-                    ),
-                  ),
-                  Text(
-                    'user@example.com',
-                    style: TextStyle(
-                      color: Colors.white70,
-                      fontSize: 14,
-                    ),
-                  ),
-                ],
-              ),
-            ),
-            ListTile(
-              leading: const Icon(Icons.home),
-              title: const Text('Home'),
-              onTap: () {
-                Navigator.pop(context);
-              },
-            ),
-            ListTile(
-              leading: const Icon(Icons.person),
-              title: const Text('Profile'),
-              onTap: () {
-                Navigator.pop(context);
-                // TODO: Navigate to profile
-              },
-            ),
-            ListTile(
-              leading: const Icon(Icons.settings),
-              title: const Text('Settings'),
-              onTap: () {
-                Navigator.pop(context);
-                // TODO: Navigate to settings
-              },
-            ),
-            const Divider(),
-            ListTile(
-              leading: const Icon(Icons.logout),
-              title: const Text('Logout'),
-              onTap: () {
-                // TODO: Implement logout
-              },
-            ),
-          ],
-        ),
-      ),
-      body: ListView(
-        padding: const EdgeInsets.all(16.0),
-        children: [
-          // Welcome card
-          Card(
-            child: Padding(
-              padding: const EdgeInsets.all(16.0),
-              child: Column(
-                crossAxisAlignment: CrossAxisAlignment.start,
-                children: [
-                  Text(
-                    'Welcome!',
-                    style: Theme.of(context).textTheme.headlineSmall,
-                  ),
-                  const SizedBox(height: 8),
-                  Text(
-                    'This is your home screen. Start building your app!',
-                    style: Theme.of(context).textTheme.bodyMedium,
-                  ),
-                ],
-              ),
-            ),
-          ),
-          const SizedBox(height: 16),
+// generateLoginScreen generates the login screen together with the sign up
+// and password reset screens it links to and the AuthBloc behind them
+func (g *ScreenGenerator) generateLoginScreen() error {
+	if err := NewAuthGenerator(g.config, g.writer).Generate(); err != nil {
+		return err
+	}
 
-          // Quick actions
-          Text(
-            'Quick Actions',
-            style: Theme.of(context).textTheme.titleLarge,
-          ),
-          const SizedBox(height: 8),
-          GridView.count(
-            shrinkWrap: true,
-            physics: const NeverScrollableScrollPhysics(),
-            crossAxisCount: 2,
-            mainAxisSpacing: 16,
-            crossAxisSpacing: 16,
-            children: [
-              _ActionCard(
-                icon: Icons.add_circle_outline,
-                title: 'Create New',
-                onTap: () {
-                  // TODO: Implement action
-                },
-              ),
-              _ActionCard(
-                icon: Icons.list_alt,
-                title: 'View All',
-                onTap: () {
-                  // TODO: Implement action
-                },
-              ),
-              _ActionCard(
-                icon: Icons.search,
-                title: 'Search',
-                onTap: () {
-                  // TODO: Implement action
-                },
-              ),
-              _ActionCard(
-                icon: Icons.favorite_outline,
-                title: 'Favorites',
-                onTap: () {
-                  // TODO: Implement action
-                },
-              ),
-            ],
-          ),
-        ],
-      ),
-      floatingActionButton: FloatingActionButton(
-        onPressed: () {
-          // TODO: Implement FAB action
-        },
-        child: const Icon(Icons.add),
-      ),
-    );
-  }
+	if err := g.renderAll(authScreenFiles); err != nil {
+		return err
+	}
+	if len(g.config.SocialSignIn) > 0 {
+		return g.render("screens/widgets/social_sign_in_buttons.dart", "lib/ui/shared/widgets/social_sign_in_buttons.dart")
+	}
+	return nil
 }
 
-class _ActionCard extends StatelessWidget {
-  final IconData icon;
-  final String title;
-  final VoidCallback onTap;
-
-  const _ActionCard({
-    required this.icon,
-    required this.title,
-    required this.onTap,
-  });
-
-  @override
-  Widget build(BuildContext context) {
-    return Card(
-      child: InkWell(
-        onTap: onTap,
-        borderRadius: BorderRadius.circular(12),
-        child: Column(
-          mainAxisAlignment: MainAxisAlignment.center,
-          children: [
-            Icon(icon, size: 48, color: Theme.of(context).primaryColor),
-            const SizedBox(height: 8),
-            Text(
-              title,
-              style: Theme.of(context).textTheme.titleMedium,
-              textAlign: TextAlign.center,
-            ),
-          ],
-        ),
-      ),
-    );
-  }
+// homeScreenFiles maps the files of the home screen to their templates
+var homeScreenFiles = []struct{ Path, Template string }{
+	{"lib/ui/home/home_page.dart", "screens/home_page.dart"},
+	{"lib/ui/home/widgets/home_drawer.dart", "screens/home_drawer.dart"},
+	{"lib/ui/home/widgets/welcome_card.dart", "screens/welcome_card.dart"},
+	{"lib/ui/home/widgets/quick_actions.dart", "screens/quick_actions.dart"},
+	{"lib/ui/home/widgets/action_card.dart", "screens/action_card.dart"},
 }
-`
 
-	return g.writer.WriteFile("lib/ui/home/home_page.dart", content)
+// profileScreenFiles maps the files of the profile screen and its
+// ProfileCubit to their templates
+var profileScreenFiles = []struct{ Path, Template string }{
+	{"lib/state_management/cubit/profile/profile_cubit.dart", "screens/profile_cubit.dart"},
+	{"lib/state_management/cubit/profile/profile_state.dart", "screens/profile_state.dart"},
+	{"lib/ui/profile/profile_page.dart", "screens/profile_page.dart"},
+	{"lib/ui/profile/widgets/profile_header.dart", "screens/profile_header.dart"},
+	{"lib/ui/profile/widgets/profile_details.dart", "screens/profile_details.dart"},
+	{"lib/ui/profile/widgets/profile_detail_tile.dart", "screens/profile_detail_tile.dart"},
+	{"lib/ui/profile/widgets/edit_profile_field_dialog.dart", "screens/edit_profile_field_dialog.dart"},
+	{"lib/ui/profile/widgets/account_actions.dart", "screens/account_actions.dart"},
 }
 
-func (g *ScreenGenerator) generateProfileScreen() error {
-	content := `import 'package:flutter/material.dart';
-import 'package:auto_route/auto_route.dart';
-
-@RoutePage()
-class ProfilePage extends StatelessWidget {
-  const ProfilePage({super.key});
+// settingsScreenFiles maps the files of the settings screen and its
+// SettingsCubit to their templates
+var settingsScreenFiles = []struct{ Path, Template string }{
+	{"lib/state_management/cubit/settings/settings_cubit.dart", "screens/settings_cubit.dart"},
+	{"lib/state_management/cubit/settings/settings_state.dart", "screens/settings_state.dart"},
+	{"lib/ui/settings/settings_page.dart", "screens/settings_page.dart"},
+	{"lib/ui/settings/widgets/section_header.dart", "screens/section_header.dart"},
+	{"lib/ui/settings/widgets/general_settings.dart", "screens/general_settings.dart"},
+	{"lib/ui/settings/widgets/app_settings.dart", "screens/app_settings.dart"},
+	{"lib/ui/settings/widgets/about_settings.dart", "screens/about_settings.dart"},
+}
 
-  @override
-  Widget build(BuildContext context) {
-    return Scaffold(
-      appBar: AppBar(
-        title: const Text('Profile'),
-        actions: [
-          IconButton(
-            icon: const Icon(Icons.edit),
-            onPressed: () {
-              // TODO: Navigate to edit profile
-            },
-          ),
-        ],
-      ),
-      body: ListView(
-        children: [
-          // Profile Header
-          Container(
-            padding: const EdgeInsets.all(24.0),
-            child: const Column(
-              children: [
-                CircleAvatar(
-                  radius: 60,
-                  child: Icon(Icons.person, size: 60),
-                ),
-                SizedBox(height: 16),
-                Text(
-                  'John Doe',
-                  style: TextStyle(
-                    fontSize: 24,
-                    fontWeight: FontWeight.bold,
-                  ),
-                ),
-                SizedBox(height: 8),
-                Text(
-                  'john.doe@example.com',
-                  style: TextStyle(
-                    fontSize: 16,
-                    color: Colors.grey,
-                  ),
-                ),
-              ],
-            ),
-          ),
+// splashScreenFiles maps the files of the splash screen and its
+// SplashCubit to their templates
+var splashScreenFiles = []struct{ Path, Template string }{
+	{"lib/state_management/cubit/splash/splash_cubit.dart", "screens/splash_cubit.dart"},
+	{"lib/ui/splash/splash_page.dart", "screens/splash_page.dart"},
+	{"lib/ui/splash/widgets/splash_view.dart", "screens/splash_view.dart"},
+}
 
-          const Divider(),
+// onboardingRepositoryFile remembers whether the onboarding was completed
+const onboardingRepositoryFile = "lib/repositories/onboarding_repository.dart"
+
+// onboardingScreenFiles maps the files of the onboarding carousel and its
+// OnboardingCubit to their templates
+var onboardingScreenFiles = []struct{ Path, Template string }{
+	{onboardingRepositoryFile, "screens/onboarding_repository.dart"},
+	{"lib/state_management/cubit/onboarding/onboarding_cubit.dart", "screens/onboarding_cubit.dart"},
+	{"lib/state_management/cubit/onboarding/onboarding_state.dart", "screens/onboarding_state.dart"},
+	{"lib/ui/onboarding/onboarding_page.dart", "screens/onboarding_page.dart"},
+	{"lib/ui/onboarding/widgets/onboarding_carousel.dart", "screens/onboarding_carousel.dart"},
+	{"lib/ui/onboarding/widgets/onboarding_slide.dart", "screens/onboarding_slide.dart"},
+	{"lib/ui/onboarding/widgets/onboarding_controls.dart", "screens/onboarding_controls.dart"},
+	{"lib/ui/onboarding/widgets/page_indicator.dart", "screens/page_indicator.dart"},
+}
 
-          // Profile Info
-          ListTile(
-            leading: const Icon(Icons.phone),
-            title: const Text('Phone'),
-            subtitle: const Text('+1 234 567 8900'),
-            trailing: const Icon(Icons.edit),
-            onTap: () {
-              // TODO: Edit phone
-            },
-          ),
-          ListTile(
-            leading: const Icon(Icons.location_on),
-            title: const Text('Location'),
-            subtitle: const Text('New York, USA'),
-            trailing: const Icon(Icons.edit),
-            onTap: () {
-              // TODO: Edit location
-            },
-          ),
-          ListTile(
-            leading: const Icon(Icons.cake),
-            title: const Text('Birthday'),
-            subtitle: const Text('January 1, 1990'),
-            trailing: const Icon(Icons.edit),
-            onTap: () {
-              // TODO: Edit birthday
-            },
-          ),
+// shellScreenFiles maps the files of the bottom navigation shell to their
+// templates
+var shellScreenFiles = []struct{ Path, Template string }{
+	{"lib/ui/app_shell/app_shell_page.dart", "screens/app_shell_page.dart"},
+	{"lib/ui/app_shell/widgets/app_navigation_bar.dart", "screens/app_navigation_bar.dart"},
+}
 
-          const Divider(),
+// stateViewFiles maps the shared empty and error state widgets, also used
+// by the screens of the models, to their templates
+var stateViewFiles = []struct{ Path, Template string }{
+	{"lib/ui/shared/widgets/empty_view.dart", "screens/widgets/empty_view.dart"},
+	{"lib/ui/shared/widgets/error_view.dart", "screens/widgets/error_view.dart"},
+}
 
-          // Account Actions
-          ListTile(
-            leading: const Icon(Icons.security),
-            title: const Text('Change Password'),
-            trailing: const Icon(Icons.chevron_right),
-            onTap: () {
-              // TODO: Navigate to change password
-            },
-          ),
-          ListTile(
-            leading: const Icon(Icons.privacy_tip),
-            title: const Text('Privacy Settings'),
-            trailing: const Icon(Icons.chevron_right),
-            onTap: () {
-              // TODO: Navigate to privacy settings
-            },
-          ),
-          ListTile(
-            leading: const Icon(Icons.delete_forever),
-            title: const Text('Delete Account'),
-            trailing: const Icon(Icons.chevron_right),
-            onTap: () {
-              // TODO: Show delete confirmation
-            },
-          ),
-        ],
-      ),
-    );
-  }
+func (g *ScreenGenerator) generateHomeScreen() error {
+	return g.renderAll(homeScreenFiles)
 }
-`
 
-	return g.writer.WriteFile("lib/ui/profile/profile_page.dart", content)
+func (g *ScreenGenerator) generateProfileScreen() error {
+	return g.renderAll(profileScreenFiles)
 }
 
 func (g *ScreenGenerator) generateSettingsScreen() error {
-	content := `import 'package:flutter/material.dart';
-import 'package:auto_route/auto_route.dart';
-
-@RoutePage()
-class SettingsPage extends StatefulWidget {
-  const SettingsPage({super.key});
-
-  @override
-  State<SettingsPage> createState() => _SettingsPageState();
+	return g.renderAll(settingsScreenFiles)
 }
 
-class _SettingsPageState extends State<SettingsPage> {
-  bool _notificationsEnabled = true;
-  bool _darkModeEnabled = false;
-  bool _autoSyncEnabled = true;
-
-  @override
-  Widget build(BuildContext context) {
-    return Scaffold(
-      appBar: AppBar(
-        title: const Text('Settings'),
-      ),
-      body: ListView(
-        children: [
-          // General Settings
-          _SectionHeader(title: 'General'),
-          SwitchListTile(
-            secondary: const Icon(Icons.notifications),
-            title: const Text('Notifications'),
-            subtitle: const Text('Enable push notifications'),
-            value: _notificationsEnabled,
-            onChanged: (value) {
-              setState(() => _notificationsEnabled = value);
-              // TODO: Save preference
-            },
-          ),
-          SwitchListTile(
-            secondary: const Icon(Icons.dark_mode),
-            title: const Text('Dark Mode'),
-            subtitle: const Text('Use dark theme'),
-            value: _darkModeEnabled,
-            onChanged: (value) {
-              setState(() => _darkModeEnabled = value);
-              // TODO: Apply theme change
-            },
-          ),
-          SwitchListTile(
-            secondary: const Icon(Icons.sync),
-            title: const Text('Auto Sync'),
-            subtitle: const Text('Automatically sync data'),
-            value: _autoSyncEnabled,
-            onChanged: (value) {
-              setState(() => _autoSyncEnabled = value);
-              // TODO: Save preference
-            },
-          ),
-
-          const Divider(),
-
-          // App Settings
-          _SectionHeader(title: 'App'),
-          ListTile(
-            leading: const Icon(Icons.language),
-            title: const Text('Language'),
-            subtitle: const Text('English'),
-            trailing: const Icon(Icons.chevron_right),
-            onTap: () {
-              // TODO: Show language picker
-            },
-          ),
-          ListTile(
-            leading: const Icon(Icons.storage),
-            title: const Text('Clear Cache'),
-            subtitle: const Text('Free up storage space'),
-            trailing: const Icon(Icons.chevron_right),
-            onTap: () {
-              // TODO: Clear cache
-              ScaffoldMessenger.of(context).showSnackBar(
-                const SnackBar(content: Text('Cache cleared')),
-              );
-            },
-          ),
-
-          const Divider(),
+// generateOnboardingScreen generates the onboarding carousel and registers
+// the OnboardingRepository it completes in the DI
+func (g *ScreenGenerator) generateOnboardingScreen() error {
+	if err := g.renderAll(onboardingScreenFiles); err != nil {
+		return err
+	}
 
-          // About
-          _SectionHeader(title: 'About'),
-          ListTile(
-            leading: const Icon(Icons.info),
-            title: const Text('Version'),
-            subtitle: const Text('1.0.0'),
-          ),
-          ListTile(
-            leading: const Icon(Icons.description),
-            title: const Text('Terms of Service'),
-            trailing: const Icon(Icons.chevron_right),
-            onTap: () {
-              // TODO: Show terms
-            },
-          ),
-          ListTile(
-            leading: const Icon(Icons.privacy_tip),
-            title: const Text('Privacy Policy'),
-            trailing: const Icon(Icons.chevron_right),
-            onTap: () {
-              // TODO: Show privacy policy
-            },
-          ),
-          ListTile(
-            leading: const Icon(Icons.help),
-            title: const Text('Help & Support'),
-            trailing: const Icon(Icons.chevron_right),
-            onTap: () {
-              // TODO: Show help
-            },
-          ),
-        ],
-      ),
-    );
-  }
+	return registerDI(g.writer, g.logger, repositoriesList,
+		[]string{packageImport(g.config.ProjectName, onboardingRepositoryFile)},
+		[]diProvider{{Type: "OnboardingRepository", Code: `RepositoryProvider<OnboardingRepository>(
+  create: (context) => OnboardingRepository(),
+),`}})
 }
 
-class _SectionHeader extends StatelessWidget {
-  final String title;
-
-  const _SectionHeader({required this.title});
-
-  @override
-  Widget build(BuildContext context) {
-    return Padding(
-      padding: const EdgeInsets.fromLTRB(16, 16, 16, 8),
-      child: Text(
-        title,
-        style: Theme.of(context).textTheme.titleSmall?.copyWith(
-              color: Theme.of(context).primaryColor,
-              fontWeight: FontWeight.bold,
-            ),
-      ),
-    );
-  }
+// renderAll renders files, stopping at the first error
+func (g *ScreenGenerator) renderAll(files []struct{ Path, Template string }) error {
+	for _, file := range files {
+		if err := g.render(file.Template, file.Path); err != nil {
+			return err
+		}
+	}
+	return nil
 }
-`
 
-	return g.writer.WriteFile("lib/ui/settings/settings_page.dart", content)
+func (g *ScreenGenerator) render(name, path string) error {
+	content, err := g.loader.Render(name, templates.NewProjectData(g.config))
+	if err != nil {
+		return err
+	}
+	return g.writer.WriteFile(path, content)
 }
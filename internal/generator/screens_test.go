@@ -0,0 +1,124 @@
+package generator
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"fline-cli/internal/config"
+	"fline-cli/internal/utils"
+)
+
+func TestScreenGeneratorGenerate(t *testing.T) {
+	isolateTemplates(t)
+
+	cfg := config.DefaultProjectConfig()
+	cfg.ProjectName = "demo_app"
+	cfg.GenerateLoginScreen = true
+	cfg.GenerateHomeScreen = true
+	cfg.GenerateProfileScreen = true
+	cfg.GenerateSettingsScreen = true
+	cfg.Normalize()
+
+	dir := t.TempDir()
+	if err := NewScreenGenerator(cfg, utils.NewFileWriter(dir)).Generate(); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	assertGolden(t, dir, "screens")
+}
+
+func TestScreenGeneratorShell(t *testing.T) {
+	isolateTemplates(t)
+
+	cfg := config.DefaultProjectConfig()
+	cfg.ProjectName = "demo_app"
+	cfg.GenerateLoginScreen = false
+	cfg.GenerateSettingsScreen = true
+	cfg.GenerateOnboardingScreen = true
+	cfg.GenerateShellScreen = true
+	cfg.GenerateStateViews = true
+	cfg.Normalize()
+
+	dir := t.TempDir()
+	if err := NewScreenGenerator(cfg, utils.NewFileWriter(dir)).Generate(); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	assertGolden(t, dir, "screens_shell")
+}
+
+// Overrides are looked up in the project the screens are generated in, not
+// in the current directory
+func TestScreenGeneratorUsesProjectOverrides(t *testing.T) {
+	isolateTemplates(t)
+
+	dir := t.TempDir()
+	override := filepath.Join(dir, ".fline", "templates", "screens", "splash_view.dart.tmpl")
+	if err := os.MkdirAll(filepath.Dir(override), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(override, []byte("// custom splash\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(t.TempDir())
+
+	cfg := config.DefaultProjectConfig()
+	cfg.ProjectName = "demo_app"
+	cfg.GenerateLoginScreen = false
+	cfg.GenerateSplashScreen = true
+	if err := NewScreenGenerator(cfg, utils.NewFileWriter(dir)).Generate(); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, "lib/ui/splash/widgets/splash_view.dart"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "// custom splash\n" {
+		t.Errorf("project override not used:\n%s", content)
+	}
+}
+
+// The screens must follow the rules of the CLAUDE.md generated with them
+func TestScreenGeneratorFollowsProjectRules(t *testing.T) {
+	isolateTemplates(t)
+
+	cfg := config.DefaultProjectConfig()
+	cfg.ProjectName = "demo_app"
+	cfg.GenerateProfileScreen = true
+	cfg.GenerateSettingsScreen = true
+	cfg.GenerateOnboardingScreen = true
+	cfg.GenerateShellScreen = true
+	cfg.GenerateStateViews = true
+	cfg.Normalize()
+
+	dir := t.TempDir()
+	if err := NewScreenGenerator(cfg, utils.NewFileWriter(dir)).Generate(); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	forbidden := regexp.MustCompile(`setState\(|Widget _build|Text\('|Colors\.`)
+	err := filepath.WalkDir(filepath.Join(dir, "lib"), func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		for i, line := range strings.Split(string(content), "\n") {
+			if forbidden.MatchString(line) {
+				rel, _ := filepath.Rel(dir, path)
+				t.Errorf("%s:%d breaks the project rules: %s", rel, i+1, strings.TrimSpace(line))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
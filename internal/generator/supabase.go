@@ -1,30 +1,36 @@
 package generator
 
 import (
+	"fmt"
+
 	"fline-cli/internal/config"
+	"fline-cli/internal/templates"
 	"fline-cli/internal/ui"
 	"fline-cli/internal/utils"
 )
 
+// supabaseEnvironments are the env files holding the Supabase credentials
+var supabaseEnvironments = []string{"dev", "prod"}
+
 // SupabaseGenerator handles Supabase integration
 type SupabaseGenerator struct {
-	config  *config.ProjectConfig
-	writer  *utils.FileWriter
-	flutter *utils.FlutterCLI
-	logger  *ui.Logger
+	config *config.ProjectConfig
+	writer *utils.FileWriter
+	loader *templates.Loader
+	logger *ui.Logger
 }
 
 // NewSupabaseGenerator creates a new Supabase generator
-func NewSupabaseGenerator(cfg *config.ProjectConfig, writer *utils.FileWriter, flutter *utils.FlutterCLI) *SupabaseGenerator {
+func NewSupabaseGenerator(cfg *config.ProjectConfig, writer *utils.FileWriter) *SupabaseGenerator {
 	return &SupabaseGenerator{
-		config:  cfg,
-		writer:  writer,
-		flutter: flutter,
-		logger:  ui.NewLogger("supabase"),
+		config: cfg,
+		writer: writer,
+		loader: templates.NewLoader(writer.BaseDir()),
+		logger: ui.NewLogger("supabase"),
 	}
 }
 
-// Generate sets up Supabase
+// Generate sets up Supabase with the modules selected in the config
 func (g *SupabaseGenerator) Generate() error {
 	g.logger.Info("Setting up Supabase integration...")
 
@@ -33,132 +39,154 @@ func (g *SupabaseGenerator) Generate() error {
 		return err
 	}
 
-	// Generate auth service
-	if err := g.generateAuthService(); err != nil {
+	// Credentials are passed with --dart-define-from-file, never compiled
+	// into the sources
+	if err := g.generateEnvFiles(); err != nil {
 		return err
 	}
 
+	modules := g.config.Supabase
+	steps := []struct {
+		enabled  bool
+		generate func() error
+	}{
+		{modules.EnableAuth, g.generateAuthService},
+		{modules.EnableDB, g.generateDatabaseService},
+		{modules.EnableStorage, g.generateStorageService},
+	}
+	for _, step := range steps {
+		if !step.enabled {
+			continue
+		}
+		if err := step.generate(); err != nil {
+			return err
+		}
+	}
+
 	g.logger.Success("Supabase integration configured")
 	g.logger.Info("Don't forget to:")
-	g.logger.Info("1. Create a Supabase project at https://supabase.com")
-	g.logger.Info("2. Get your project URL and anon key")
-	g.logger.Info("3. Update lib/utils/supabase_client.dart with your credentials")
+	step := 1
+	if g.config.Supabase.ProjectURL == "" || g.config.Supabase.AnonKey == "" {
+		g.logger.Info("1. Create a Supabase project at https://supabase.com")
+		g.logger.Info("2. Put your project URL and anon key in env/dev.env and env/prod.env")
+		step = 3
+	}
+	g.logger.Info(fmt.Sprintf("%d. Run: flutter run --dart-define-from-file=env/dev.env", step))
 
 	return nil
 }
 
 func (g *SupabaseGenerator) generateSupabaseClient() error {
-	content := `import 'package:supabase_flutter/supabase_flutter.dart';
-
-class SupabaseConfig {
-  static const String supabaseUrl = 'YOUR_SUPABASE_URL';
-  static const String supabaseAnonKey = 'YOUR_SUPABASE_ANON_KEY';
+	if err := g.render("supabase/supabase_client.dart", "lib/utils/supabase_client.dart"); err != nil {
+		return err
+	}
 
-  static Future<void> initialize() async {
-    await Supabase.initialize(
-      url: supabaseUrl,
-      anonKey: supabaseAnonKey,
-    );
-  }
+	if err := registerProviders(g.writer, g.logger,
+		[]string{"package:supabase_flutter/supabase_flutter.dart"},
+		[]diProvider{
+			{Type: "SupabaseClient", Code: `Provider<SupabaseClient>(create: (_) => Supabase.instance.client),`},
+		}); err != nil {
+		return err
+	}
 
-  static SupabaseClient get client => Supabase.instance.client;
+	return wireMain(g.writer, g.logger,
+		[]string{packageImport(g.config.ProjectName, "lib/utils/supabase_client.dart")},
+		[]string{"await SupabaseConfig.initialize();"})
 }
-`
 
-	return g.writer.WriteFile("lib/utils/supabase_client.dart", content)
+// generateEnvFiles writes one env file per environment plus an example to
+// commit. Existing env files hold real credentials, so they are never
+// replaced.
+func (g *SupabaseGenerator) generateEnvFiles() error {
+	data := templates.NewProjectData(g.config)
+	example := data
+	example.SupabaseURL = ""
+	example.SupabaseAnonKey = ""
+
+	paths := []string{"env/example.env"}
+	for _, env := range supabaseEnvironments {
+		paths = append(paths, "env/"+env+".env")
+	}
+
+	for _, path := range paths {
+		if g.writer.PathExists(path) {
+			continue
+		}
+		d := data
+		if path == "env/example.env" {
+			d = example
+		}
+		content, err := g.loader.Render("supabase/env", d)
+		if err != nil {
+			return err
+		}
+		if err := g.writer.WriteFile(path, content); err != nil {
+			return err
+		}
+	}
+
+	if err := addGitignoreEntries(g.writer, g.logger, "Environment files with credentials", "env/*.env", "!env/example.env"); err != nil {
+		return err
+	}
+
+	return g.render("supabase/launch.json", ".vscode/launch.json")
 }
 
 func (g *SupabaseGenerator) generateAuthService() error {
-	content := `import 'package:logger/logger.dart';
-import 'package:supabase_flutter/supabase_flutter.dart';
-
-class SupabaseAuthService {
-  final SupabaseClient _client;
-  final Logger _logger;
-
-  SupabaseAuthService({
-    required SupabaseClient client,
-    required Logger logger,
-  })  : _client = client,
-        _logger = logger;
-
-  // Get current user
-  User? get currentUser => _client.auth.currentUser;
-
-  // Auth state changes
-  Stream<AuthState> get authStateChanges => _client.auth.onAuthStateChange;
-
-  // Sign in with email and password
-  Future<AuthResponse> signInWithEmailAndPassword({
-    required String email,
-    required String password,
-  }) async {
-    try {
-      return await _client.auth.signInWithPassword(
-        email: email,
-        password: password,
-      );
-    } catch (e) {
-      _logger.e('Sign in error', error: e);
-      rethrow;
-    }
-  }
-
-  // Register with email and password
-  Future<AuthResponse> signUp({
-    required String email,
-    required String password,
-  }) async {
-    try {
-      return await _client.auth.signUp(
-        email: email,
-        password: password,
-      );
-    } catch (e) {
-      _logger.e('Sign up error', error: e);
-      rethrow;
-    }
-  }
-
-  // Sign out
-  Future<void> signOut() async {
-    try {
-      await _client.auth.signOut();
-    } catch (e) {
-      _logger.e('Sign out error', error: e);
-      rethrow;
-    }
-  }
-
-  // Reset password
-  Future<void> resetPassword(String email) async {
-    try {
-      await _client.auth.resetPasswordForEmail(email);
-    } catch (e) {
-      _logger.e('Password reset error', error: e);
-      rethrow;
-    }
-  }
+	if err := g.render("supabase/auth_service.dart", "lib/network/service/supabase_auth_service.dart"); err != nil {
+		return err
+	}
+
+	return registerProviders(g.writer, g.logger,
+		[]string{packageImport(g.config.ProjectName, "lib/network/service/supabase_auth_service.dart")},
+		[]diProvider{
+			{Type: "SupabaseAuthService", Code: `Provider<SupabaseAuthService>(
+  create: (context) => SupabaseAuthService(
+    client: context.read<SupabaseClient>(),
+    logger: context.read<Logger>(),
+  ),
+),`},
+		})
 }
-`
 
-	if err := g.writer.WriteFile("lib/network/service/supabase_auth_service.dart", content); err != nil {
+func (g *SupabaseGenerator) generateDatabaseService() error {
+	if err := g.render("supabase/database_service.dart", "lib/network/service/supabase_database_service.dart"); err != nil {
 		return err
 	}
 
-	// Add note about providers
-	providerContent := `
-  // Supabase
-  Provider<SupabaseClient>(create: (_) => Supabase.instance.client),
-  Provider<SupabaseAuthService>(
-    create: (context) => SupabaseAuthService(
-      client: context.read<SupabaseClient>(),
-      logger: context.read<Logger>(),
-    ),
+	return registerProviders(g.writer, g.logger,
+		[]string{packageImport(g.config.ProjectName, "lib/network/service/supabase_database_service.dart")},
+		[]diProvider{
+			{Type: "SupabaseDatabaseService", Code: `Provider<SupabaseDatabaseService>(
+  create: (context) => SupabaseDatabaseService(
+    client: context.read<SupabaseClient>(),
+    logger: context.read<Logger>(),
   ),
-`
-	g.logger.Warning("Remember to add Supabase providers to lib/di/providers.dart:")
-	g.logger.Info(providerContent)
+),`},
+		})
+}
 
-	return nil
+func (g *SupabaseGenerator) generateStorageService() error {
+	if err := g.render("supabase/storage_service.dart", "lib/network/service/supabase_storage_service.dart"); err != nil {
+		return err
+	}
+
+	return registerProviders(g.writer, g.logger,
+		[]string{packageImport(g.config.ProjectName, "lib/network/service/supabase_storage_service.dart")},
+		[]diProvider{
+			{Type: "SupabaseStorageService", Code: `Provider<SupabaseStorageService>(
+  create: (context) => SupabaseStorageService(
+    client: context.read<SupabaseClient>(),
+    logger: context.read<Logger>(),
+  ),
+),`},
+		})
+}
+
+func (g *SupabaseGenerator) render(name, path string) error {
+	content, err := g.loader.Render(name, templates.NewProjectData(g.config))
+	if err != nil {
+		return err
+	}
+	return g.writer.WriteFile(path, content)
 }
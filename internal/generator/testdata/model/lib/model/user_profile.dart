@@ -0,0 +1,41 @@
+import 'package:json_annotation/json_annotation.dart';
+import 'package:equatable/equatable.dart';
+
+part 'user_profile.g.dart';
+
+@JsonSerializable()
+class UserProfile extends Equatable {
+  final bool active;
+  final Map<String, dynamic> address;
+  final dynamic deletedAt;
+  final int id;
+  final String name;
+  final double rating;
+  final List<String> tags;
+
+  const UserProfile({
+    required this.active,
+    required this.address,
+    required this.deletedAt,
+    required this.id,
+    required this.name,
+    required this.rating,
+    required this.tags
+  });
+
+  factory UserProfile.fromJson(Map<String, dynamic> json) =>
+      _$UserProfileFromJson(json);
+
+  Map<String, dynamic> toJson() => _$UserProfileToJson(this);
+
+  @override
+  List<Object?> get props => [
+    active,
+    address,
+    deletedAt,
+    id,
+    name,
+    rating,
+    tags
+  ];
+}
@@ -0,0 +1,35 @@
+import 'package:dio/dio.dart';
+import 'package:retrofit/retrofit.dart';
+import 'package:demo_app/model/user_profile.dart';
+
+part 'user_profile_service.g.dart';
+
+@RestApi()
+abstract class UserProfileService {
+  factory UserProfileService(Dio dio) = _UserProfileService;
+
+  @GET('/api/user-profiles')
+  Future<List<UserProfile>> getAll();
+
+  /// Returns the [page]th page, from 1, of [limit] items
+  @GET('/api/user-profiles')
+  Future<List<UserProfile>> getPage(
+    @Query('page') int page,
+    @Query('limit') int limit,
+  );
+
+  @GET('/api/user-profiles/{id}')
+  Future<UserProfile> getById(@Path('id') String id);
+
+  @POST('/api/user-profiles')
+  Future<UserProfile> create(@Body() UserProfile userProfile);
+
+  @PUT('/api/user-profiles/{id}')
+  Future<UserProfile> update(
+    @Path('id') String id,
+    @Body() UserProfile userProfile,
+  );
+
+  @DELETE('/api/user-profiles/{id}')
+  Future<void> delete(@Path('id') String id);
+}
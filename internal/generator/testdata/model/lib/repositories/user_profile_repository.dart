@@ -0,0 +1,68 @@
+import 'package:logger/logger.dart';
+import 'package:demo_app/model/user_profile.dart';
+import 'package:demo_app/network/service/user_profile_service.dart';
+
+class UserProfileRepository {
+  final UserProfileService _service;
+  final Logger _logger;
+
+  UserProfileRepository({
+    required UserProfileService service,
+    required Logger logger,
+  })  : _service = service,
+        _logger = logger;
+
+  Future<List<UserProfile>> getAll() async {
+    try {
+      return await _service.getAll();
+    } catch (e) {
+      _logger.e('Error fetching UserProfiles', error: e);
+      rethrow;
+    }
+  }
+
+  Future<List<UserProfile>> getPage(int page, int limit) async {
+    try {
+      return await _service.getPage(page, limit);
+    } catch (e) {
+      _logger.e('Error fetching page $page of UserProfiles', error: e);
+      rethrow;
+    }
+  }
+
+  Future<UserProfile> getById(String id) async {
+    try {
+      return await _service.getById(id);
+    } catch (e) {
+      _logger.e('Error fetching UserProfile', error: e);
+      rethrow;
+    }
+  }
+
+  Future<UserProfile> create(UserProfile userProfile) async {
+    try {
+      return await _service.create(userProfile);
+    } catch (e) {
+      _logger.e('Error creating UserProfile', error: e);
+      rethrow;
+    }
+  }
+
+  Future<UserProfile> update(String id, UserProfile userProfile) async {
+    try {
+      return await _service.update(id, userProfile);
+    } catch (e) {
+      _logger.e('Error updating UserProfile', error: e);
+      rethrow;
+    }
+  }
+
+  Future<void> delete(String id) async {
+    try {
+      await _service.delete(id);
+    } catch (e) {
+      _logger.e('Error deleting UserProfile', error: e);
+      rethrow;
+    }
+  }
+}
@@ -0,0 +1,113 @@
+import 'package:flutter_bloc/flutter_bloc.dart';
+import 'package:equatable/equatable.dart';
+import 'package:demo_app/repositories/user_profile_repository.dart';
+import 'package:demo_app/model/user_profile.dart';
+
+part 'user_profile_event.dart';
+part 'user_profile_state.dart';
+
+class UserProfileBloc extends Bloc<UserProfileEvent, UserProfileState> {
+  /// Items per page of FetchUserProfiles
+  static const pageSize = 20;
+
+  final UserProfileRepository _repository;
+
+  UserProfileBloc({required UserProfileRepository repository})
+      : _repository = repository,
+        super(UserProfileInitial()) {
+    on<FetchUserProfiles>(_onFetchUserProfiles);
+    on<FetchUserProfile>(_onFetchUserProfile);
+    on<CreateUserProfile>(_onCreateUserProfile);
+    on<UpdateUserProfile>(_onUpdateUserProfile);
+    on<DeleteUserProfile>(_onDeleteUserProfile);
+  }
+
+  Future<void> _onFetchUserProfiles(
+    FetchUserProfiles event,
+    Emitter<UserProfileState> emit,
+  ) async {
+    var items = const <UserProfile>[];
+    final current = state;
+    if (event.page > 1) {
+      // Pages are appended in order, one at a time
+      if (current is! UserProfilesLoaded ||
+          !current.hasMore ||
+          current.loadingMore ||
+          event.page != current.page + 1) {
+        return;
+      }
+      items = current.items;
+      emit(UserProfilesLoaded(
+        items,
+        page: current.page,
+        hasMore: true,
+        loadingMore: true,
+      ));
+    } else {
+      emit(UserProfileLoading());
+    }
+
+    try {
+      final loaded = await _repository.getPage(event.page, event.limit);
+      emit(UserProfilesLoaded(
+        [...items, ...loaded],
+        page: event.page,
+        hasMore: loaded.length == event.limit,
+      ));
+    } catch (e) {
+      emit(UserProfileError(e.toString()));
+    }
+  }
+
+  Future<void> _onFetchUserProfile(
+    FetchUserProfile event,
+    Emitter<UserProfileState> emit,
+  ) async {
+    emit(UserProfileLoading());
+    try {
+      final item = await _repository.getById(event.id);
+      emit(UserProfileLoaded(item));
+    } catch (e) {
+      emit(UserProfileError(e.toString()));
+    }
+  }
+
+  Future<void> _onCreateUserProfile(
+    CreateUserProfile event,
+    Emitter<UserProfileState> emit,
+  ) async {
+    emit(UserProfileLoading());
+    try {
+      await _repository.create(event.userProfile);
+      emit(UserProfileCreated());
+    } catch (e) {
+      emit(UserProfileError(e.toString()));
+    }
+  }
+
+  Future<void> _onUpdateUserProfile(
+    UpdateUserProfile event,
+    Emitter<UserProfileState> emit,
+  ) async {
+    emit(UserProfileLoading());
+    try {
+      await _repository.update(event.id, event.userProfile);
+      emit(UserProfileUpdated());
+    } catch (e) {
+      emit(UserProfileError(e.toString()));
+    }
+  }
+
+  Future<void> _onDeleteUserProfile(
+    DeleteUserProfile event,
+    Emitter<UserProfileState> emit,
+  ) async {
+    emit(UserProfileLoading());
+    try {
+      await _repository.delete(event.id);
+      emit(UserProfileDeleted());
+    } catch (e) {
+      emit(UserProfileError(e.toString()));
+    }
+  }
+}
@@ -0,0 +1,60 @@
+part of 'user_profile_bloc.dart';
+
+abstract class UserProfileEvent extends Equatable {
+  const UserProfileEvent();
+
+  @override
+  List<Object> get props => [];
+}
+
+/// Loads a page of items, from 1, and appends it to the pages loaded
+/// before. The first page starts over.
+class FetchUserProfiles extends UserProfileEvent {
+  final int page;
+  final int limit;
+
+  const FetchUserProfiles({
+    this.page = 1,
+    this.limit = UserProfileBloc.pageSize,
+  });
+
+  @override
+  List<Object> get props => [page, limit];
+}
+
+class FetchUserProfile extends UserProfileEvent {
+  final String id;
+
+  const FetchUserProfile({required this.id});
+
+  @override
+  List<Object> get props => [id];
+}
+
+class CreateUserProfile extends UserProfileEvent {
+  final UserProfile userProfile;
+
+  const CreateUserProfile({required this.userProfile});
+
+  @override
+  List<Object> get props => [userProfile];
+}
+
+class UpdateUserProfile extends UserProfileEvent {
+  final String id;
+  final UserProfile userProfile;
+
+  const UpdateUserProfile({required this.id, required this.userProfile});
+
+  @override
+  List<Object> get props => [id, userProfile];
+}
+
+class DeleteUserProfile extends UserProfileEvent {
+  final String id;
+
+  const DeleteUserProfile({required this.id});
+
+  @override
+  List<Object> get props => [id];
+}
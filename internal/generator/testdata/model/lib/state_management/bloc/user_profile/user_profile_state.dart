@@ -0,0 +1,53 @@
+part of 'user_profile_bloc.dart';
+
+abstract class UserProfileState extends Equatable {
+  const UserProfileState();
+
+  @override
+  List<Object> get props => [];
+}
+
+class UserProfileInitial extends UserProfileState {}
+
+class UserProfileLoading extends UserProfileState {}
+
+class UserProfilesLoaded extends UserProfileState {
+  final List<UserProfile> items;
+  final int page; // Last page loaded, 0 when the items aren't paged
+  final bool hasMore; // Whether the next page may have items
+  final bool loadingMore; // Whether the next page is loading
+
+  const UserProfilesLoaded(
+    this.items, {
+    this.page = 0,
+    this.hasMore = false,
+    this.loadingMore = false,
+  });
+
+  @override
+  List<Object> get props => [items, page, hasMore, loadingMore];
+}
+
+class UserProfileLoaded extends UserProfileState {
+  final UserProfile item;
+
+  const UserProfileLoaded(this.item);
+
+  @override
+  List<Object> get props => [item];
+}
+
+class UserProfileCreated extends UserProfileState {}
+
+class UserProfileUpdated extends UserProfileState {}
+
+class UserProfileDeleted extends UserProfileState {}
+
+class UserProfileError extends UserProfileState {
+  final String message;
+
+  const UserProfileError(this.message);
+
+  @override
+  List<Object> get props => [message];
+}
@@ -0,0 +1,80 @@
+// Security rules tests of the tasks collection, generated by fline.
+// Run them against the emulator from the project root:
+//   firebase emulators:exec --only firestore "npm --prefix firestore-tests test"
+const { readFileSync } = require('node:fs');
+const { resolve } = require('node:path');
+const {
+  assertFails,
+  assertSucceeds,
+  initializeTestEnvironment,
+} = require('@firebase/rules-unit-testing');
+const { deleteDoc, doc, getDoc, setDoc } = require('firebase/firestore');
+
+const path = 'tasks/document';
+
+// A document that passes the type checks
+const valid = {
+  done: true,
+  ownerId: 'alice',
+  title: 'text',
+};
+
+describe('tasks rules', () => {
+  let env;
+
+  before(async () => {
+    env = await initializeTestEnvironment({
+      projectId: 'demo-task-rules',
+      firestore: {
+        rules: readFileSync(resolve(__dirname, '../firestore.rules'), 'utf8'),
+      },
+    });
+  });
+
+  after(() => env.cleanup());
+
+  beforeEach(() => env.clearFirestore());
+
+  it('rejects signed out users', async () => {
+    const db = env.unauthenticatedContext().firestore();
+    await assertFails(setDoc(doc(db, path), valid));
+    await assertFails(getDoc(doc(db, path)));
+  });
+
+  it('accepts a valid document', async () => {
+    const db = env.authenticatedContext('alice').firestore();
+    await assertSucceeds(setDoc(doc(db, path), valid));
+    await assertSucceeds(getDoc(doc(db, path)));
+    await assertSucceeds(deleteDoc(doc(db, path)));
+  });
+
+  it('rejects unknown fields', async () => {
+    const db = env.authenticatedContext('alice').firestore();
+    await assertFails(setDoc(doc(db, path), { ...valid, unexpected: true }));
+  });
+
+  it('rejects fields of the wrong type', async () => {
+    const db = env.authenticatedContext('alice').firestore();
+    const field = Object.keys(valid).find((key) => valid[key] !== null);
+    const wrong = typeof valid[field] === 'string' ? 1 : 'text';
+    await assertFails(setDoc(doc(db, path), { ...valid, [field]: wrong }));
+  });
+
+  it("keeps other users' documents private", async () => {
+    await env.withSecurityRulesDisabled((context) =>
+      setDoc(doc(context.firestore(), path), valid),
+    );
+
+    const db = env.authenticatedContext('bob').firestore();
+    await assertFails(getDoc(doc(db, path)));
+    await assertFails(setDoc(doc(db, path), { ...valid, ownerId: 'bob' }));
+    await assertFails(deleteDoc(doc(db, path)));
+  });
+
+  it('rejects documents owned by someone else', async () => {
+    const db = env.authenticatedContext('alice').firestore();
+    await assertFails(setDoc(doc(db, path), { ...valid, ownerId: 'bob' }));
+  });
+
+  // Add a test for every rule you write by hand
+});
@@ -0,0 +1,32 @@
+import 'package:json_annotation/json_annotation.dart';
+import 'package:equatable/equatable.dart';
+
+part 'task.g.dart';
+
+@JsonSerializable()
+class Task extends Equatable {
+  final bool done;
+  final String id;
+  final String ownerId;
+  final String title;
+
+  const Task({
+    required this.done,
+    required this.id,
+    required this.ownerId,
+    required this.title
+  });
+
+  factory Task.fromJson(Map<String, dynamic> json) =>
+      _$TaskFromJson(json);
+
+  Map<String, dynamic> toJson() => _$TaskToJson(this);
+
+  @override
+  List<Object?> get props => [
+    done,
+    id,
+    ownerId,
+    title
+  ];
+}
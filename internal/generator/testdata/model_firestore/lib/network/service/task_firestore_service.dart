@@ -0,0 +1,97 @@
+import 'package:cloud_firestore/cloud_firestore.dart';
+import 'package:firebase_auth/firebase_auth.dart';
+import 'package:demo_app/model/task.dart';
+
+/// Data source of the `tasks` Firestore collection. Documents are
+/// converted to Task by the collection itself, so every query
+/// is typed. The id field holds the document ID and is not
+/// stored in the document.
+///
+/// Queries only return the documents of the signed in user: the security
+/// rules in firestore.rules reject any query that could read others.
+class TaskFirestoreService {
+  final CollectionReference<Task> _collection;
+  final FirebaseAuth _auth;
+
+  // Last document of each page read, where the next page starts
+  final _pageEnds = <int, DocumentSnapshot<Task>>{};
+
+  TaskFirestoreService({
+    required FirebaseFirestore firestore,
+    required FirebaseAuth auth,
+  })  : _auth = auth,
+        _collection = firestore
+            .collection('tasks')
+            .withConverter<Task>(
+              fromFirestore: (snapshot, _) => Task.fromJson(
+                {...snapshot.data()!, 'id': snapshot.id},
+              ),
+              toFirestore: (task, _) =>
+                  task.toJson()..remove('id'),
+            );
+
+  Query<Task> get _query =>
+      _collection.where('ownerId', isEqualTo: _auth.currentUser?.uid);
+
+  Future<List<Task>> getAll() async {
+    final snapshot = await _query.get();
+    return snapshot.docs.map((doc) => doc.data()).toList();
+  }
+
+  /// Returns the [page]th page, from 1, of [limit] documents in document ID
+  /// order. Pages are read in order: each one starts after the last
+  /// document of the previous one.
+  Future<List<Task>> getPage(int page, int limit) async {
+    var query = _query.limit(limit);
+    if (page > 1) {
+      final previous = _pageEnds[page - 1];
+      if (previous == null) {
+        throw StateError('Page ${page - 1} of tasks was not read');
+      }
+      query = query.startAfterDocument(previous);
+    }
+
+    final snapshot = await query.get();
+    if (snapshot.docs.isNotEmpty) {
+      _pageEnds[page] = snapshot.docs.last;
+    }
+    return snapshot.docs.map((doc) => doc.data()).toList();
+  }
+
+  Future<Task?> getById(String id) async {
+    final snapshot = await _collection.doc(id).get();
+    return snapshot.data();
+  }
+
+  /// Returns the documents whose [field] equals [value]
+  Future<List<Task>> findBy(String field, Object? value) async {
+    final snapshot = await _query.where(field, isEqualTo: value).get();
+    return snapshot.docs.map((doc) => doc.data()).toList();
+  }
+
+  /// Adds a document with a generated ID and returns the ID
+  Future<String> create(Task task) async {
+    final reference = await _collection.add(task);
+    return reference.id;
+  }
+
+  Future<void> update(String id, Task task) {
+    return _collection.doc(id).set(task);
+  }
+
+  Future<void> delete(String id) {
+    return _collection.doc(id).delete();
+  }
+
+  /// Emits the whole collection again on every change
+  Stream<List<Task>> watchAll() {
+    return _query.snapshots().map(
+          (snapshot) => snapshot.docs.map((doc) => doc.data()).toList(),
+        );
+  }
+
+  /// Emits the document on every change, null once it is deleted
+  Stream<Task?> watch(String id) {
+    return _collection.doc(id).snapshots().map((snapshot) => snapshot.data());
+  }
+}
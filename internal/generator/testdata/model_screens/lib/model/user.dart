@@ -0,0 +1,44 @@
+import 'package:json_annotation/json_annotation.dart';
+import 'package:equatable/equatable.dart';
+
+part 'user.g.dart';
+
+@JsonSerializable()
+class User extends Equatable {
+  final bool active;
+  final Map<String, dynamic> address;
+  final int age;
+  final dynamic deletedAt;
+  final String firstName;
+  final int id;
+  final double rating;
+  final List<String> tags;
+
+  const User({
+    required this.active,
+    required this.address,
+    required this.age,
+    required this.deletedAt,
+    required this.firstName,
+    required this.id,
+    required this.rating,
+    required this.tags
+  });
+
+  factory User.fromJson(Map<String, dynamic> json) =>
+      _$UserFromJson(json);
+
+  Map<String, dynamic> toJson() => _$UserToJson(this);
+
+  @override
+  List<Object?> get props => [
+    active,
+    address,
+    age,
+    deletedAt,
+    firstName,
+    id,
+    rating,
+    tags
+  ];
+}
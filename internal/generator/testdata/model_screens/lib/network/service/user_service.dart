@@ -0,0 +1,35 @@
+import 'package:dio/dio.dart';
+import 'package:retrofit/retrofit.dart';
+import 'package:demo_app/model/user.dart';
+
+part 'user_service.g.dart';
+
+@RestApi()
+abstract class UserService {
+  factory UserService(Dio dio) = _UserService;
+
+  @GET('/api/users')
+  Future<List<User>> getAll();
+
+  /// Returns the [page]th page, from 1, of [limit] items
+  @GET('/api/users')
+  Future<List<User>> getPage(
+    @Query('page') int page,
+    @Query('limit') int limit,
+  );
+
+  @GET('/api/users/{id}')
+  Future<User> getById(@Path('id') String id);
+
+  @POST('/api/users')
+  Future<User> create(@Body() User user);
+
+  @PUT('/api/users/{id}')
+  Future<User> update(
+    @Path('id') String id,
+    @Body() User user,
+  );
+
+  @DELETE('/api/users/{id}')
+  Future<void> delete(@Path('id') String id);
+}
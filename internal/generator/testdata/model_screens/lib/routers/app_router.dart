@@ -0,0 +1,18 @@
+import 'package:auto_route/auto_route.dart';
+import 'package:demo_app/routers/app_router.gr.dart';
+import 'package:demo_app/routers/auth_guard.dart';
+
+@AutoRouterConfig(
+  replaceInRouteName: 'Page,Route',
+)
+class AppRouter extends RootStackRouter {
+  final _authGuard = AuthGuard();
+
+  @override
+  List<AutoRoute> get routes => [
+        AutoRoute(page: HomeRoute.page, initial: true),
+        AutoRoute(page: UserListRoute.page, guards: [_authGuard]),
+        AutoRoute(page: UserDetailRoute.page, guards: [_authGuard]),
+        AutoRoute(page: UserFormRoute.page, guards: [_authGuard]),
+      ];
+}
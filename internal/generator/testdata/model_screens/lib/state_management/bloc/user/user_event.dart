@@ -0,0 +1,60 @@
+part of 'user_bloc.dart';
+
+abstract class UserEvent extends Equatable {
+  const UserEvent();
+
+  @override
+  List<Object> get props => [];
+}
+
+/// Loads a page of items, from 1, and appends it to the pages loaded
+/// before. The first page starts over.
+class FetchUsers extends UserEvent {
+  final int page;
+  final int limit;
+
+  const FetchUsers({
+    this.page = 1,
+    this.limit = UserBloc.pageSize,
+  });
+
+  @override
+  List<Object> get props => [page, limit];
+}
+
+class FetchUser extends UserEvent {
+  final String id;
+
+  const FetchUser({required this.id});
+
+  @override
+  List<Object> get props => [id];
+}
+
+class CreateUser extends UserEvent {
+  final User user;
+
+  const CreateUser({required this.user});
+
+  @override
+  List<Object> get props => [user];
+}
+
+class UpdateUser extends UserEvent {
+  final String id;
+  final User user;
+
+  const UpdateUser({required this.id, required this.user});
+
+  @override
+  List<Object> get props => [id, user];
+}
+
+class DeleteUser extends UserEvent {
+  final String id;
+
+  const DeleteUser({required this.id});
+
+  @override
+  List<Object> get props => [id];
+}
@@ -0,0 +1,53 @@
+part of 'user_bloc.dart';
+
+abstract class UserState extends Equatable {
+  const UserState();
+
+  @override
+  List<Object> get props => [];
+}
+
+class UserInitial extends UserState {}
+
+class UserLoading extends UserState {}
+
+class UsersLoaded extends UserState {
+  final List<User> items;
+  final int page; // Last page loaded, 0 when the items aren't paged
+  final bool hasMore; // Whether the next page may have items
+  final bool loadingMore; // Whether the next page is loading
+
+  const UsersLoaded(
+    this.items, {
+    this.page = 0,
+    this.hasMore = false,
+    this.loadingMore = false,
+  });
+
+  @override
+  List<Object> get props => [items, page, hasMore, loadingMore];
+}
+
+class UserLoaded extends UserState {
+  final User item;
+
+  const UserLoaded(this.item);
+
+  @override
+  List<Object> get props => [item];
+}
+
+class UserCreated extends UserState {}
+
+class UserUpdated extends UserState {}
+
+class UserDeleted extends UserState {}
+
+class UserError extends UserState {
+  final String message;
+
+  const UserError(this.message);
+
+  @override
+  List<Object> get props => [message];
+}
@@ -0,0 +1,40 @@
+import 'package:flutter/material.dart';
+import 'package:demo_app/l10n/app_localizations.dart';
+
+// Required field of an int, or of a double when decimal
+class NumberInputField extends StatelessWidget {
+  final TextEditingController controller;
+  final String label;
+  final bool decimal;
+
+  const NumberInputField({
+    super.key,
+    required this.controller,
+    required this.label,
+    this.decimal = false,
+  });
+
+  String? _validate(BuildContext context, String? value) {
+    final l10n = AppLocalizations.of(context)!;
+    final text = value?.trim() ?? '';
+    if (text.isEmpty) {
+      return l10n.fieldRequired;
+    }
+    final number = decimal ? double.tryParse(text) : int.tryParse(text);
+    return number == null ? l10n.numberInvalid : null;
+  }
+
+  @override
+  Widget build(BuildContext context) {
+    return TextFormField(
+      controller: controller,
+      decoration: InputDecoration(labelText: label),
+      keyboardType: TextInputType.numberWithOptions(
+        signed: true,
+        decimal: decimal,
+      ),
+      textInputAction: TextInputAction.next,
+      validator: (value) => _validate(context, value),
+    );
+  }
+}
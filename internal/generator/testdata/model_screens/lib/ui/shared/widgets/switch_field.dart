@@ -0,0 +1,29 @@
+import 'package:flutter/material.dart';
+
+// On/off form field, read through fieldKey
+class SwitchField extends StatelessWidget {
+  final GlobalKey<FormFieldState<bool>> fieldKey;
+  final String label;
+  final bool initialValue;
+
+  const SwitchField({
+    super.key,
+    required this.fieldKey,
+    required this.label,
+    this.initialValue = false,
+  });
+
+  @override
+  Widget build(BuildContext context) {
+    return FormField<bool>(
+      key: fieldKey,
+      initialValue: initialValue,
+      builder: (field) => SwitchListTile(
+        contentPadding: EdgeInsets.zero,
+        title: Text(label),
+        value: field.value ?? false,
+        onChanged: field.didChange,
+      ),
+    );
+  }
+}
@@ -0,0 +1,26 @@
+import 'package:flutter/material.dart';
+import 'package:demo_app/l10n/app_localizations.dart';
+
+// Required text field
+class TextInputField extends StatelessWidget {
+  final TextEditingController controller;
+  final String label;
+
+  const TextInputField({
+    super.key,
+    required this.controller,
+    required this.label,
+  });
+
+  @override
+  Widget build(BuildContext context) {
+    return TextFormField(
+      controller: controller,
+      decoration: InputDecoration(labelText: label),
+      textInputAction: TextInputAction.next,
+      validator: (value) => (value == null || value.trim().isEmpty)
+          ? AppLocalizations.of(context)!.fieldRequired
+          : null,
+    );
+  }
+}
@@ -0,0 +1,90 @@
+import 'package:auto_route/auto_route.dart';
+import 'package:flutter/material.dart';
+import 'package:flutter_bloc/flutter_bloc.dart';
+import 'package:demo_app/l10n/app_localizations.dart';
+import 'package:demo_app/model/user.dart';
+import 'package:demo_app/repositories/user_repository.dart';
+import 'package:demo_app/routers/app_router.gr.dart';
+import 'package:demo_app/state_management/bloc/user/user_bloc.dart';
+import 'package:demo_app/ui/shared/widgets/error_view.dart';
+import 'package:demo_app/ui/user_detail/widgets/user_details.dart';
+
+@RoutePage()
+class UserDetailPage extends StatelessWidget {
+  final String id;
+
+  const UserDetailPage({super.key, required this.id});
+
+  @override
+  Widget build(BuildContext context) {
+    return BlocProvider(
+      create: (context) => UserBloc(
+        repository: context.read<UserRepository>(),
+      )..add(FetchUser(id: id)),
+      child: _UserDetailView(id: id),
+    );
+  }
+}
+
+class _UserDetailView extends StatelessWidget {
+  final String id;
+
+  const _UserDetailView({required this.id});
+
+  Future<void> _edit(BuildContext context, User item) async {
+    final bloc = context.read<UserBloc>();
+    final saved = await context.router.push<bool>(
+      UserFormRoute(user: item),
+    );
+    if (saved ?? false) {
+      bloc.add(FetchUser(id: id));
+    }
+  }
+
+  @override
+  Widget build(BuildContext context) {
+    final l10n = AppLocalizations.of(context)!;
+
+    return BlocConsumer<UserBloc, UserState>(
+      listener: (context, state) {
+        if (state is UserDeleted) {
+          context.router.maybePop();
+        }
+      },
+      builder: (context, state) {
+        return Scaffold(
+          appBar: AppBar(
+            title: Text(l10n.userDetailTitle),
+            actions: [
+              if (state is UserLoaded) ...[
+                IconButton(
+                  icon: const Icon(Icons.edit),
+                  tooltip: l10n.editButton,
+                  onPressed: () => _edit(context, state.item),
+                ),
+                IconButton(
+                  icon: const Icon(Icons.delete_outline),
+                  tooltip: l10n.deleteButton,
+                  onPressed: () => context
+                      .read<UserBloc>()
+                      .add(DeleteUser(id: id)),
+                ),
+              ],
+            ],
+          ),
+          body: switch (state) {
+            UserLoaded(:final item) => UserDetails(item: item),
+            UserError() => ErrorView(
+                message: l10n.loadErrorMessage,
+                retryLabel: l10n.retryButton,
+                onRetry: () => context
+                    .read<UserBloc>()
+                    .add(FetchUser(id: id)),
+              ),
+            _ => const Center(child: CircularProgressIndicator()),
+          },
+        );
+      },
+    );
+  }
+}
@@ -0,0 +1,52 @@
+import 'package:flutter/material.dart';
+import 'package:demo_app/l10n/app_localizations.dart';
+import 'package:demo_app/model/user.dart';
+
+// Fields of a User
+class UserDetails extends StatelessWidget {
+  final User item;
+
+  const UserDetails({super.key, required this.item});
+
+  @override
+  Widget build(BuildContext context) {
+    final l10n = AppLocalizations.of(context)!;
+
+    return ListView(
+      children: [
+        ListTile(
+          title: Text(l10n.userActiveLabel),
+          subtitle: Text(item.active ? l10n.yesLabel : l10n.noLabel),
+        ),
+        ListTile(
+          title: Text(l10n.userAddressLabel),
+          subtitle: Text(item.address.toString()),
+        ),
+        ListTile(
+          title: Text(l10n.userAgeLabel),
+          subtitle: Text(item.age.toString()),
+        ),
+        ListTile(
+          title: Text(l10n.userDeletedAtLabel),
+          subtitle: Text(item.deletedAt?.toString() ?? ''),
+        ),
+        ListTile(
+          title: Text(l10n.userFirstNameLabel),
+          subtitle: Text(item.firstName),
+        ),
+        ListTile(
+          title: Text(l10n.userIdLabel),
+          subtitle: Text(item.id.toString()),
+        ),
+        ListTile(
+          title: Text(l10n.userRatingLabel),
+          subtitle: Text(item.rating.toString()),
+        ),
+        ListTile(
+          title: Text(l10n.userTagsLabel),
+          subtitle: Text(item.tags.join(', ')),
+        ),
+      ],
+    );
+  }
+}
@@ -0,0 +1,49 @@
+import 'package:auto_route/auto_route.dart';
+import 'package:flutter/material.dart';
+import 'package:flutter_bloc/flutter_bloc.dart';
+import 'package:demo_app/l10n/app_localizations.dart';
+import 'package:demo_app/model/user.dart';
+import 'package:demo_app/repositories/user_repository.dart';
+import 'package:demo_app/state_management/bloc/user/user_bloc.dart';
+import 'package:demo_app/ui/user_form/widgets/user_form.dart';
+
+// Creates a User, or edits user when given. Pops true once saved.
+@RoutePage()
+class UserFormPage extends StatelessWidget {
+  final User? user;
+
+  const UserFormPage({super.key, this.user});
+
+  @override
+  Widget build(BuildContext context) {
+    final l10n = AppLocalizations.of(context)!;
+
+    return BlocProvider(
+      create: (context) => UserBloc(
+        repository: context.read<UserRepository>(),
+      ),
+      child: BlocListener<UserBloc, UserState>(
+        listener: (context, state) {
+          if (state is UserCreated || state is UserUpdated) {
+            context.router.maybePop(true);
+          } else if (state is UserError) {
+            ScaffoldMessenger.of(context).showSnackBar(
+              SnackBar(content: Text(l10n.saveErrorMessage)),
+            );
+          }
+        },
+        child: Scaffold(
+          appBar: AppBar(
+            title: Text(
+              user == null ? l10n.userCreateTitle : l10n.userEditTitle,
+            ),
+          ),
+          body: SingleChildScrollView(
+            padding: const EdgeInsets.all(16),
+            child: UserForm(user: user),
+          ),
+        ),
+      ),
+    );
+  }
+}
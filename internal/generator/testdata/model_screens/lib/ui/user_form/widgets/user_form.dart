@@ -0,0 +1,111 @@
+import 'package:flutter/material.dart';
+import 'package:flutter_bloc/flutter_bloc.dart';
+import 'package:demo_app/l10n/app_localizations.dart';
+import 'package:demo_app/model/user.dart';
+import 'package:demo_app/state_management/bloc/user/user_bloc.dart';
+import 'package:demo_app/ui/shared/widgets/number_input_field.dart';
+import 'package:demo_app/ui/shared/widgets/switch_field.dart';
+import 'package:demo_app/ui/shared/widgets/text_input_field.dart';
+
+// The form keeps its controllers only, the request state is in
+// UserBloc. Fields the form can't edit keep their value.
+class UserForm extends StatefulWidget {
+  final User? user;
+
+  const UserForm({super.key, this.user});
+
+  @override
+  State<UserForm> createState() => _UserFormState();
+}
+
+class _UserFormState extends State<UserForm> {
+  final _formKey = GlobalKey<FormState>();
+  final _activeKey = GlobalKey<FormFieldState<bool>>();
+  late final _ageController =
+      TextEditingController(text: widget.user?.age.toString());
+  late final _firstNameController =
+      TextEditingController(text: widget.user?.firstName);
+  late final _ratingController =
+      TextEditingController(text: widget.user?.rating.toString());
+
+  @override
+  void dispose() {
+    _ageController.dispose();
+    _firstNameController.dispose();
+    _ratingController.dispose();
+    super.dispose();
+  }
+
+  void _submit() {
+    if (!(_formKey.currentState?.validate() ?? false)) {
+      return;
+    }
+
+    final edited = widget.user;
+    final user = User(
+      active: _activeKey.currentState?.value ?? false,
+      address: edited?.address ?? const {},
+      age: int.parse(_ageController.text.trim()),
+      deletedAt: edited?.deletedAt,
+      firstName: _firstNameController.text.trim(),
+      id: edited?.id ?? 0,
+      rating: double.parse(_ratingController.text.trim()),
+      tags: edited?.tags ?? const [],
+    );
+
+    final bloc = context.read<UserBloc>();
+    if (edited == null) {
+      bloc.add(CreateUser(user: user));
+    } else {
+      bloc.add(UpdateUser(id: edited.id.toString(), user: user));
+    }
+  }
+
+  @override
+  Widget build(BuildContext context) {
+    final l10n = AppLocalizations.of(context)!;
+
+    return Form(
+      key: _formKey,
+      child: Column(
+        crossAxisAlignment: CrossAxisAlignment.stretch,
+        spacing: 16,
+        children: [
+          SwitchField(
+            fieldKey: _activeKey,
+            label: l10n.userActiveLabel,
+            initialValue: widget.user?.active ?? false,
+          ),
+          NumberInputField(
+            controller: _ageController,
+            label: l10n.userAgeLabel,
+          ),
+          TextInputField(
+            controller: _firstNameController,
+            label: l10n.userFirstNameLabel,
+          ),
+          NumberInputField(
+            controller: _ratingController,
+            label: l10n.userRatingLabel,
+            decimal: true,
+          ),
+          BlocBuilder<UserBloc, UserState>(
+            builder: (context, state) {
+              final saving = state is UserLoading;
+
+              return FilledButton(
+                onPressed: saving ? null : _submit,
+                child: saving
+                    ? const SizedBox.square(
+                        dimension: 20,
+                        child: CircularProgressIndicator(strokeWidth: 2),
+                      )
+                    : Text(l10n.saveButton),
+              );
+            },
+          ),
+        ],
+      ),
+    );
+  }
+}
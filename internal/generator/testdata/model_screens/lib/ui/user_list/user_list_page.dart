@@ -0,0 +1,50 @@
+import 'package:auto_route/auto_route.dart';
+import 'package:flutter/material.dart';
+import 'package:flutter_bloc/flutter_bloc.dart';
+import 'package:demo_app/l10n/app_localizations.dart';
+import 'package:demo_app/repositories/user_repository.dart';
+import 'package:demo_app/routers/app_router.gr.dart';
+import 'package:demo_app/state_management/bloc/user/user_bloc.dart';
+import 'package:demo_app/ui/user_list/widgets/user_list.dart';
+
+@RoutePage()
+class UserListPage extends StatelessWidget {
+  const UserListPage({super.key});
+
+  @override
+  Widget build(BuildContext context) {
+    return BlocProvider(
+      create: (context) => UserBloc(
+        repository: context.read<UserRepository>(),
+      )..add(FetchUsers()),
+      child: const _UserListView(),
+    );
+  }
+}
+
+class _UserListView extends StatelessWidget {
+  const _UserListView();
+
+  Future<void> _create(BuildContext context) async {
+    final bloc = context.read<UserBloc>();
+    final saved = await context.router.push<bool>(UserFormRoute());
+    if (saved ?? false) {
+      bloc.add(FetchUsers());
+    }
+  }
+
+  @override
+  Widget build(BuildContext context) {
+    final l10n = AppLocalizations.of(context)!;
+
+    return Scaffold(
+      appBar: AppBar(title: Text(l10n.userListTitle)),
+      body: const UserList(),
+      floatingActionButton: FloatingActionButton(
+        tooltip: l10n.userCreateTitle,
+        onPressed: () => _create(context),
+        child: const Icon(Icons.add),
+      ),
+    );
+  }
+}
@@ -0,0 +1,81 @@
+import 'package:flutter/material.dart';
+import 'package:flutter_bloc/flutter_bloc.dart';
+import 'package:demo_app/l10n/app_localizations.dart';
+import 'package:demo_app/model/user.dart';
+import 'package:demo_app/state_management/bloc/user/user_bloc.dart';
+import 'package:demo_app/ui/shared/widgets/empty_view.dart';
+import 'package:demo_app/ui/shared/widgets/error_view.dart';
+import 'package:demo_app/ui/user_list/widgets/user_list_tile.dart';
+
+// Items loaded by UserBloc a page at a time, pulled to refresh
+class UserList extends StatelessWidget {
+  const UserList({super.key});
+
+  // Completes once the items are loaded again
+  Future<void> _refresh(BuildContext context) async {
+    final bloc = context.read<UserBloc>();
+    bloc.add(FetchUsers());
+    await bloc.stream.firstWhere((state) => state is! UserLoading);
+  }
+
+  @override
+  Widget build(BuildContext context) {
+    final l10n = AppLocalizations.of(context)!;
+
+    return BlocBuilder<UserBloc, UserState>(
+      // The loaded items stay on screen while refreshing
+      buildWhen: (previous, current) =>
+          current is! UserLoading || previous is! UsersLoaded,
+      builder: (context, state) => switch (state) {
+        UsersLoaded loaded => RefreshIndicator(
+            onRefresh: () => _refresh(context),
+            child: loaded.items.isEmpty
+                ? EmptyView(message: l10n.emptyListMessage)
+                : _PagedList(state: loaded),
+          ),
+        UserError() => ErrorView(
+            message: l10n.loadErrorMessage,
+            retryLabel: l10n.retryButton,
+            onRetry: () =>
+                context.read<UserBloc>().add(FetchUsers()),
+          ),
+        _ => const Center(child: CircularProgressIndicator()),
+      },
+    );
+  }
+}
+
+// Shows the pages loaded, and loads the next one when scrolling to the end
+class _PagedList extends StatelessWidget {
+  final UsersLoaded state;
+
+  const _PagedList({required this.state});
+
+  @override
+  Widget build(BuildContext context) {
+    final items = state.items;
+
+    return NotificationListener<ScrollNotification>(
+      onNotification: (notification) {
+        if (state.hasMore &&
+            !state.loadingMore &&
+            notification.metrics.extentAfter < 200) {
+          context
+              .read<UserBloc>()
+              .add(FetchUsers(page: state.page + 1));
+        }
+        return false;
+      },
+      child: ListView.builder(
+        physics: const AlwaysScrollableScrollPhysics(),
+        itemCount: state.hasMore ? items.length + 1 : items.length,
+        itemBuilder: (context, index) => index < items.length
+            ? UserListTile(item: items[index])
+            : const Padding(
+                padding: EdgeInsets.all(16),
+                child: Center(child: CircularProgressIndicator()),
+              ),
+      ),
+    );
+  }
+}
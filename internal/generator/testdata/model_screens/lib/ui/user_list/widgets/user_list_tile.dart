@@ -0,0 +1,28 @@
+import 'package:auto_route/auto_route.dart';
+import 'package:flutter/material.dart';
+import 'package:flutter_bloc/flutter_bloc.dart';
+import 'package:demo_app/model/user.dart';
+import 'package:demo_app/routers/app_router.gr.dart';
+import 'package:demo_app/state_management/bloc/user/user_bloc.dart';
+
+class UserListTile extends StatelessWidget {
+  final User item;
+
+  const UserListTile({super.key, required this.item});
+
+  // The item may have been edited or deleted on the detail screen
+  Future<void> _open(BuildContext context) async {
+    final bloc = context.read<UserBloc>();
+    await context.router.push(UserDetailRoute(id: item.id.toString()));
+    bloc.add(FetchUsers());
+  }
+
+  @override
+  Widget build(BuildContext context) {
+    return ListTile(
+      title: Text(item.firstName),
+      trailing: const Icon(Icons.chevron_right),
+      onTap: () => _open(context),
+    );
+  }
+}
@@ -0,0 +1,38 @@
+import 'package:json_annotation/json_annotation.dart';
+import 'package:equatable/equatable.dart';
+
+part 'task.g.dart';
+
+@JsonSerializable()
+class Task extends Equatable {
+  final bool done;
+  final int id;
+  final Map<String, dynamic> meta;
+  final List<String> tags;
+  final String title;
+  final String userId;
+
+  const Task({
+    required this.done,
+    required this.id,
+    required this.meta,
+    required this.tags,
+    required this.title,
+    required this.userId
+  });
+
+  factory Task.fromJson(Map<String, dynamic> json) =>
+      _$TaskFromJson(json);
+
+  Map<String, dynamic> toJson() => _$TaskToJson(this);
+
+  @override
+  List<Object?> get props => [
+    done,
+    id,
+    meta,
+    tags,
+    title,
+    userId
+  ];
+}
@@ -0,0 +1,88 @@
+import 'package:supabase_flutter/supabase_flutter.dart';
+import 'package:demo_app/model/task.dart';
+
+/// Data source of the `tasks` Supabase table, created by the
+/// migration in supabase/migrations
+class TaskSupabaseService {
+  static const table = 'tasks';
+
+  final SupabaseClient _client;
+
+  TaskSupabaseService({required SupabaseClient client})
+      : _client = client;
+
+  Future<List<Task>> getAll() async {
+    final rows = await _client.from(table).select();
+    return rows.map(Task.fromJson).toList();
+  }
+
+  /// Returns the [page]th page, from 1, of [limit] rows in id order
+  Future<List<Task>> getPage(int page, int limit) async {
+    final from = (page - 1) * limit;
+    final rows = await _client
+        .from(table)
+        .select()
+        .order('id', ascending: true)
+        .range(from, from + limit - 1);
+    return rows.map(Task.fromJson).toList();
+  }
+
+  Future<Task?> getById(String id) async {
+    final row = await _client.from(table).select().eq('id', id).maybeSingle();
+    return row == null ? null : Task.fromJson(row);
+  }
+
+  /// Returns the rows whose [column] equals [value]
+  Future<List<Task>> findBy(String column, Object value) async {
+    final rows = await _client.from(table).select().eq(column, value);
+    return rows.map(Task.fromJson).toList();
+  }
+
+  Future<Task> create(Task task) async {
+    final row = await _client
+        .from(table)
+        .insert(_values(task))
+        .select()
+        .single();
+    return Task.fromJson(row);
+  }
+
+  Future<Task> update(String id, Task task) async {
+    final row = await _client
+        .from(table)
+        .update(_values(task))
+        .eq('id', id)
+        .select()
+        .single();
+    return Task.fromJson(row);
+  }
+
+  Future<void> delete(String id) async {
+    await _client.from(table).delete().eq('id', id);
+  }
+
+  /// Emits the whole table again on every change. The table must be in the
+  /// supabase_realtime publication, which the migration takes care of.
+  Stream<List<Task>> watchAll() {
+    return _client
+        .from(table)
+        .stream(primaryKey: ['id'])
+        .map((rows) => rows.map(Task.fromJson).toList());
+  }
+
+  /// Emits the row on every change, null once it is deleted
+  Stream<Task?> watch(String id) {
+    return _client
+        .from(table)
+        .stream(primaryKey: ['id'])
+        .eq('id', id)
+        .map((rows) => rows.isEmpty ? null : Task.fromJson(rows.first));
+  }
+
+  /// Row values to write, without the columns the database sets
+  Map<String, dynamic> _values(Task task) {
+    return task.toJson()
+      ..remove('id')
+      ..remove('userId');
+  }
+}
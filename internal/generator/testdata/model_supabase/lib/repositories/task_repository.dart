@@ -0,0 +1,96 @@
+import 'package:logger/logger.dart';
+import 'package:demo_app/model/task.dart';
+import 'package:demo_app/network/service/task_supabase_service.dart';
+
+class TaskRepository {
+  final TaskSupabaseService _service;
+  final Logger _logger;
+
+  TaskRepository({
+    required TaskSupabaseService service,
+    required Logger logger,
+  })  : _service = service,
+        _logger = logger;
+
+  Future<List<Task>> getAll() async {
+    try {
+      return await _service.getAll();
+    } catch (e) {
+      _logger.e('Error fetching Tasks', error: e);
+      rethrow;
+    }
+  }
+
+  Future<List<Task>> getPage(int page, int limit) async {
+    try {
+      return await _service.getPage(page, limit);
+    } catch (e) {
+      _logger.e('Error fetching page $page of Tasks', error: e);
+      rethrow;
+    }
+  }
+
+  Future<Task> getById(String id) async {
+    try {
+      final task = await _service.getById(id);
+      if (task == null) {
+        throw StateError('Task $id not found');
+      }
+      return task;
+    } catch (e) {
+      _logger.e('Error fetching Task', error: e);
+      rethrow;
+    }
+  }
+
+  Future<List<Task>> findBy(String column, Object value) async {
+    try {
+      return await _service.findBy(column, value);
+    } catch (e) {
+      _logger.e('Error querying Tasks by $column', error: e);
+      rethrow;
+    }
+  }
+
+  /// Returns the row as stored, with its primary key
+  Future<Task> create(Task task) async {
+    try {
+      return await _service.create(task);
+    } catch (e) {
+      _logger.e('Error creating Task', error: e);
+      rethrow;
+    }
+  }
+
+  Future<Task> update(String id, Task task) async {
+    try {
+      return await _service.update(id, task);
+    } catch (e) {
+      _logger.e('Error updating Task', error: e);
+      rethrow;
+    }
+  }
+
+  Future<void> delete(String id) async {
+    try {
+      await _service.delete(id);
+    } catch (e) {
+      _logger.e('Error deleting Task', error: e);
+      rethrow;
+    }
+  }
+
+  Stream<List<Task>> watchAll() {
+    return _service.watchAll().handleError((Object e) {
+      _logger.e('Error watching Tasks', error: e);
+      throw e;
+    });
+  }
+
+  Stream<Task?> watch(String id) {
+    return _service.watch(id).handleError((Object e) {
+      _logger.e('Error watching Task', error: e);
+      throw e;
+    });
+  }
+}
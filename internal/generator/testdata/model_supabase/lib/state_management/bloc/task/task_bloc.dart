@@ -0,0 +1,127 @@
+import 'package:flutter_bloc/flutter_bloc.dart';
+import 'package:equatable/equatable.dart';
+import 'package:demo_app/repositories/task_repository.dart';
+import 'package:demo_app/model/task.dart';
+
+part 'task_event.dart';
+part 'task_state.dart';
+
+class TaskBloc extends Bloc<TaskEvent, TaskState> {
+  /// Items per page of FetchTasks
+  static const pageSize = 20;
+
+  final TaskRepository _repository;
+
+  TaskBloc({required TaskRepository repository})
+      : _repository = repository,
+        super(TaskInitial()) {
+    on<FetchTasks>(_onFetchTasks);
+    on<FetchTask>(_onFetchTask);
+    on<CreateTask>(_onCreateTask);
+    on<UpdateTask>(_onUpdateTask);
+    on<DeleteTask>(_onDeleteTask);
+    on<WatchTasks>(_onWatchTasks);
+  }
+
+  Future<void> _onFetchTasks(
+    FetchTasks event,
+    Emitter<TaskState> emit,
+  ) async {
+    var items = const <Task>[];
+    final current = state;
+    if (event.page > 1) {
+      // Pages are appended in order, one at a time
+      if (current is! TasksLoaded ||
+          !current.hasMore ||
+          current.loadingMore ||
+          event.page != current.page + 1) {
+        return;
+      }
+      items = current.items;
+      emit(TasksLoaded(
+        items,
+        page: current.page,
+        hasMore: true,
+        loadingMore: true,
+      ));
+    } else {
+      emit(TaskLoading());
+    }
+
+    try {
+      final loaded = await _repository.getPage(event.page, event.limit);
+      emit(TasksLoaded(
+        [...items, ...loaded],
+        page: event.page,
+        hasMore: loaded.length == event.limit,
+      ));
+    } catch (e) {
+      emit(TaskError(e.toString()));
+    }
+  }
+
+  Future<void> _onFetchTask(
+    FetchTask event,
+    Emitter<TaskState> emit,
+  ) async {
+    emit(TaskLoading());
+    try {
+      final item = await _repository.getById(event.id);
+      emit(TaskLoaded(item));
+    } catch (e) {
+      emit(TaskError(e.toString()));
+    }
+  }
+
+  Future<void> _onCreateTask(
+    CreateTask event,
+    Emitter<TaskState> emit,
+  ) async {
+    emit(TaskLoading());
+    try {
+      await _repository.create(event.task);
+      emit(TaskCreated());
+    } catch (e) {
+      emit(TaskError(e.toString()));
+    }
+  }
+
+  Future<void> _onUpdateTask(
+    UpdateTask event,
+    Emitter<TaskState> emit,
+  ) async {
+    emit(TaskLoading());
+    try {
+      await _repository.update(event.id, event.task);
+      emit(TaskUpdated());
+    } catch (e) {
+      emit(TaskError(e.toString()));
+    }
+  }
+
+  Future<void> _onDeleteTask(
+    DeleteTask event,
+    Emitter<TaskState> emit,
+  ) async {
+    emit(TaskLoading());
+    try {
+      await _repository.delete(event.id);
+      emit(TaskDeleted());
+    } catch (e) {
+      emit(TaskError(e.toString()));
+    }
+  }
+
+  /// Emits the items on every change until the BLoC is closed
+  Future<void> _onWatchTasks(
+    WatchTasks event,
+    Emitter<TaskState> emit,
+  ) async {
+    emit(TaskLoading());
+    await emit.forEach<List<Task>>(
+      _repository.watchAll(),
+      onData: TasksLoaded.new,
+      onError: (error, _) => TaskError(error.toString()),
+    );
+  }
+}
@@ -0,0 +1,63 @@
+part of 'task_bloc.dart';
+
+abstract class TaskEvent extends Equatable {
+  const TaskEvent();
+
+  @override
+  List<Object> get props => [];
+}
+
+/// Loads a page of items, from 1, and appends it to the pages loaded
+/// before. The first page starts over.
+class FetchTasks extends TaskEvent {
+  final int page;
+  final int limit;
+
+  const FetchTasks({
+    this.page = 1,
+    this.limit = TaskBloc.pageSize,
+  });
+
+  @override
+  List<Object> get props => [page, limit];
+}
+
+class FetchTask extends TaskEvent {
+  final String id;
+
+  const FetchTask({required this.id});
+
+  @override
+  List<Object> get props => [id];
+}
+
+class CreateTask extends TaskEvent {
+  final Task task;
+
+  const CreateTask({required this.task});
+
+  @override
+  List<Object> get props => [task];
+}
+
+class UpdateTask extends TaskEvent {
+  final String id;
+  final Task task;
+
+  const UpdateTask({required this.id, required this.task});
+
+  @override
+  List<Object> get props => [id, task];
+}
+
+class DeleteTask extends TaskEvent {
+  final String id;
+
+  const DeleteTask({required this.id});
+
+  @override
+  List<Object> get props => [id];
+}
+
+/// Keeps the items in sync with the data source
+class WatchTasks extends TaskEvent {}
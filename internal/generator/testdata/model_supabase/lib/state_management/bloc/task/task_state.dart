@@ -0,0 +1,53 @@
+part of 'task_bloc.dart';
+
+abstract class TaskState extends Equatable {
+  const TaskState();
+
+  @override
+  List<Object> get props => [];
+}
+
+class TaskInitial extends TaskState {}
+
+class TaskLoading extends TaskState {}
+
+class TasksLoaded extends TaskState {
+  final List<Task> items;
+  final int page; // Last page loaded, 0 when the items aren't paged
+  final bool hasMore; // Whether the next page may have items
+  final bool loadingMore; // Whether the next page is loading
+
+  const TasksLoaded(
+    this.items, {
+    this.page = 0,
+    this.hasMore = false,
+    this.loadingMore = false,
+  });
+
+  @override
+  List<Object> get props => [items, page, hasMore, loadingMore];
+}
+
+class TaskLoaded extends TaskState {
+  final Task item;
+
+  const TaskLoaded(this.item);
+
+  @override
+  List<Object> get props => [item];
+}
+
+class TaskCreated extends TaskState {}
+
+class TaskUpdated extends TaskState {}
+
+class TaskDeleted extends TaskState {}
+
+class TaskError extends TaskState {
+  final String message;
+
+  const TaskError(this.message);
+
+  @override
+  List<Object> get props => [message];
+}
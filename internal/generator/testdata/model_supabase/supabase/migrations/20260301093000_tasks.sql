@@ -0,0 +1,34 @@
+-- Task model table, generated by fline from the model fields.
+-- Review the column types and policies before running supabase db push.
+
+create table if not exists public.tasks (
+  id bigint generated by default as identity primary key,
+  done boolean not null,
+  meta jsonb not null,
+  tags text[] not null,
+  title text not null,
+  "userId" uuid not null default auth.uid() references auth.users (id) on delete cascade
+);
+
+alter table public.tasks enable row level security;
+
+-- Users only see and change their own rows
+create policy "Owners can read their tasks"
+  on public.tasks for select to authenticated
+  using ((select auth.uid()) = "userId");
+
+create policy "Owners can insert their tasks"
+  on public.tasks for insert to authenticated
+  with check ((select auth.uid()) = "userId");
+
+create policy "Owners can update their tasks"
+  on public.tasks for update to authenticated
+  using ((select auth.uid()) = "userId")
+  with check ((select auth.uid()) = "userId");
+
+create policy "Owners can delete their tasks"
+  on public.tasks for delete to authenticated
+  using ((select auth.uid()) = "userId");
+
+-- Stream changes to watchAll() and watch()
+alter publication supabase_realtime add table public.tasks;
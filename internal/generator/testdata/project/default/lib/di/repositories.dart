@@ -0,0 +1,11 @@
+part of 'dependency_injector.dart';
+
+final List<RepositoryProvider> _repositories = [
+  // Add your repositories here
+  RepositoryProvider<AuthRepository>(
+    create: (context) => AuthRepository(
+      tokens: context.read<TokenStore>(),
+      logger: context.read<Logger>(),
+    ),
+  ),
+];
@@ -0,0 +1,82 @@
+import 'dart:async';
+
+import 'package:logger/logger.dart';
+import 'package:demo_app/repositories/token_store.dart';
+
+// Reasons an authentication request fails, shown to the user
+enum AuthErrorCode {
+  invalidCredentials,
+  emailAlreadyInUse,
+  weakPassword,
+  invalidEmail,
+  tooManyRequests,
+  network,
+  unknown,
+}
+
+class AuthFailure implements Exception {
+  final AuthErrorCode code;
+
+  const AuthFailure(this.code);
+
+  @override
+  String toString() => 'AuthFailure($code)';
+}
+
+// No auth backend was selected: connect these methods to your API and
+// save the tokens it returns in TokenStore. Until then every request fails.
+class AuthRepository {
+  final TokenStore _tokens;
+  final Logger _logger;
+  final _signedInChanges = StreamController<bool>.broadcast();
+  bool _signedIn = false;
+
+  AuthRepository({
+    required TokenStore tokens,
+    required Logger logger,
+  })  : _tokens = tokens,
+        _logger = logger;
+
+  bool get isSignedIn => _signedIn;
+
+  // Emits whether a user is signed in, on every change
+  Stream<bool> get signedInChanges => _signedInChanges.stream;
+
+  // The session is the access token kept in the secure storage
+  Future<bool> restoreSession() async {
+    _setSignedIn(await _tokens.accessToken != null);
+    return _signedIn;
+  }
+
+  Future<void> signIn({
+    required String email,
+    required String password,
+  }) =>
+      _notConnected('Sign in');
+
+  // Returns whether the new user is signed in
+  Future<bool> signUp({
+    required String email,
+    required String password,
+  }) =>
+      _notConnected('Sign up');
+
+  Future<void> resetPassword(String email) => _notConnected('Password reset');
+
+  Future<void> signOut() async {
+    await _tokens.clear();
+    _setSignedIn(false);
+  }
+
+  void _setSignedIn(bool signedIn) {
+    if (signedIn != _signedIn) {
+      _signedIn = signedIn;
+      _signedInChanges.add(signedIn);
+    }
+  }
+
+  Future<Never> _notConnected(String action) async {
+    _logger.w('$action: AuthRepository is not connected to a backend');
+    throw const AuthFailure(AuthErrorCode.unknown);
+  }
+}
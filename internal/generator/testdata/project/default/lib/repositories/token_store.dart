@@ -0,0 +1,30 @@
+import 'package:flutter_secure_storage/flutter_secure_storage.dart';
+
+// Keeps the session tokens of the API in the platform secure storage
+class TokenStore {
+  static const _accessTokenKey = 'access_token';
+  static const _refreshTokenKey = 'refresh_token';
+
+  final FlutterSecureStorage _storage;
+
+  TokenStore({required FlutterSecureStorage storage}) : _storage = storage;
+
+  Future<String?> get accessToken => _storage.read(key: _accessTokenKey);
+
+  Future<String?> get refreshToken => _storage.read(key: _refreshTokenKey);
+
+  Future<void> save({
+    required String accessToken,
+    String? refreshToken,
+  }) async {
+    await _storage.write(key: _accessTokenKey, value: accessToken);
+    if (refreshToken != null) {
+      await _storage.write(key: _refreshTokenKey, value: refreshToken);
+    }
+  }
+
+  Future<void> clear() async {
+    await _storage.delete(key: _accessTokenKey);
+    await _storage.delete(key: _refreshTokenKey);
+  }
+}
@@ -0,0 +1,36 @@
+import 'package:flutter_bloc/flutter_bloc.dart';
+import 'package:demo_app/repositories/auth_repository.dart';
+
+// Screens the app can start on
+enum SplashDestination {
+  login,
+  main,
+}
+
+// Decides the screen the app starts on, null while the splash screen shows
+class SplashCubit extends Cubit<SplashDestination?> {
+  final AuthRepository _auth;
+
+  // Keeps the splash screen from flashing when starting is instant
+  static const _minimumDuration = Duration(milliseconds: 800);
+
+  SplashCubit({required AuthRepository auth})
+      : _auth = auth,
+        super(null);
+
+  Future<void> start() async {
+    final destination = _destination();
+    await Future<void>.delayed(_minimumDuration);
+    emit(await destination);
+  }
+
+  Future<SplashDestination> _destination() async {
+    // A session that can't be restored counts as signed out
+    try {
+      final signedIn = await _auth.restoreSession();
+      return signedIn ? SplashDestination.main : SplashDestination.login;
+    } on AuthFailure {
+      return SplashDestination.login;
+    }
+  }
+}
@@ -0,0 +1,49 @@
+import 'package:auto_route/auto_route.dart';
+import 'package:flutter/material.dart';
+import 'package:flutter_bloc/flutter_bloc.dart';
+import 'package:demo_app/l10n/app_localizations.dart';
+import 'package:demo_app/repositories/auth_repository.dart';
+import 'package:demo_app/routers/app_router.gr.dart';
+import 'package:demo_app/state_management/bloc/auth/auth_bloc.dart';
+import 'package:demo_app/ui/sign_up/widgets/sign_up_form.dart';
+import 'package:demo_app/utils/auth_error_messages.dart';
+
+@RoutePage()
+class SignUpPage extends StatelessWidget {
+  const SignUpPage({super.key});
+
+  @override
+  Widget build(BuildContext context) {
+    return BlocProvider(
+      create: (context) => AuthBloc(repository: context.read<AuthRepository>()),
+      child: BlocListener<AuthBloc, AuthState>(
+        listener: (context, state) {
+          final l10n = AppLocalizations.of(context)!;
+          if (state is Authenticated) {
+            context.router.replaceAll([const HomeRoute()]);
+          } else if (state is SignUpConfirmationSent) {
+            ScaffoldMessenger.of(context).showSnackBar(
+              SnackBar(content: Text(l10n.signUpConfirmationSent)),
+            );
+            context.router.maybePop();
+          } else if (state is AuthError) {
+            ScaffoldMessenger.of(context).showSnackBar(
+              SnackBar(content: Text(state.code.message(l10n))),
+            );
+          }
+        },
+        child: Scaffold(
+          appBar: AppBar(),
+          body: const SafeArea(
+            child: Center(
+              child: SingleChildScrollView(
+                padding: EdgeInsets.all(24),
+                child: SignUpForm(),
+              ),
+            ),
+          ),
+        ),
+      ),
+    );
+  }
+}
@@ -0,0 +1,15 @@
+import 'package:demo_app/l10n/app_localizations.dart';
+import 'package:demo_app/repositories/auth_repository.dart';
+
+extension AuthErrorMessage on AuthErrorCode {
+  // Localized message of the error, shown to the user
+  String message(AppLocalizations l10n) => switch (this) {
+        AuthErrorCode.invalidCredentials => l10n.authErrorInvalidCredentials,
+        AuthErrorCode.emailAlreadyInUse => l10n.authErrorEmailInUse,
+        AuthErrorCode.weakPassword => l10n.authErrorWeakPassword,
+        AuthErrorCode.invalidEmail => l10n.authErrorInvalidEmail,
+        AuthErrorCode.tooManyRequests => l10n.authErrorTooManyRequests,
+        AuthErrorCode.network => l10n.authErrorNetwork,
+        AuthErrorCode.unknown => l10n.authErrorUnknown,
+      };
+}
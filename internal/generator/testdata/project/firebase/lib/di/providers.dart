@@ -0,0 +1,73 @@
+part of 'dependency_injector.dart';
+
+final List<SingleChildWidget> _providers = [
+  Provider<Logger>(create: (_) => Logger()),
+
+  Provider<PrettyDioLogger>(
+      create: (_) => PrettyDioLogger(
+          requestBody: true, compact: true, requestHeader: true)),
+
+  Provider<Dio>(
+      create: (context) => Dio()
+        ..interceptors
+            .addAll([if (kDebugMode) context.read<PrettyDioLogger>()])),
+
+  Provider<FlutterSecureStorage>(
+    create: (_) => const FlutterSecureStorage(),
+  ),
+
+  Provider<FirebaseAuth>(create: (_) => FirebaseAuth.instance),
+
+  Provider<AuthService>(
+    create: (context) => AuthService(
+      auth: context.read<FirebaseAuth>(),
+      logger: context.read<Logger>(),
+    ),
+  ),
+
+  Provider<FirebaseFirestore>(create: (_) => FirebaseFirestore.instance),
+
+  Provider<FirebaseStorage>(create: (_) => FirebaseStorage.instance),
+
+  Provider<StorageService>(
+    create: (context) => StorageService(
+      storage: context.read<FirebaseStorage>(),
+      logger: context.read<Logger>(),
+    ),
+  ),
+
+  Provider<FirebaseMessaging>(create: (_) => FirebaseMessaging.instance),
+
+  Provider<LocalNotificationService>(
+    create: (context) => LocalNotificationService(
+      logger: context.read<Logger>(),
+    ),
+  ),
+
+  Provider<NotificationService>(
+    create: (context) => FcmNotificationService(
+      messaging: context.read<FirebaseMessaging>(),
+      local: context.read<LocalNotificationService>(),
+      logger: context.read<Logger>(),
+    ),
+  ),
+
+  Provider<NotificationRouter>(
+    lazy: false,
+    create: (context) => NotificationRouter(
+      service: context.read<NotificationService>(),
+      router: router,
+      logger: context.read<Logger>(),
+    )..start(),
+    dispose: (_, notificationRouter) => notificationRouter.dispose(),
+  ),
+
+  Provider<FirebaseAnalytics>(create: (_) => FirebaseAnalytics.instance),
+
+  Provider<AnalyticsService>(
+    create: (context) => AnalyticsService(
+      analytics: context.read<FirebaseAnalytics>(),
+      logger: context.read<Logger>(),
+    ),
+  ),
+];
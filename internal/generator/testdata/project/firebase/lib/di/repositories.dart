@@ -0,0 +1,11 @@
+part of 'dependency_injector.dart';
+
+final List<RepositoryProvider> _repositories = [
+  // Add your repositories here
+  RepositoryProvider<AuthRepository>(
+    create: (context) => AuthRepository(
+      service: context.read<AuthService>(),
+      logger: context.read<Logger>(),
+    ),
+  ),
+];
@@ -0,0 +1,28 @@
+// Placeholder generated by fline: no Firebase configuration file was found.
+// Run `flutterfire configure` to replace it with options for every platform.
+import 'package:firebase_core/firebase_core.dart' show FirebaseOptions;
+import 'package:flutter/foundation.dart'
+    show defaultTargetPlatform, kIsWeb, TargetPlatform;
+
+class DefaultFirebaseOptions {
+  static FirebaseOptions get currentPlatform {
+    if (kIsWeb) {
+      throw _notConfigured('web');
+    }
+    switch (defaultTargetPlatform) {
+      case TargetPlatform.android:
+        throw _notConfigured('Android');
+      case TargetPlatform.iOS:
+        throw _notConfigured('iOS');
+      default:
+        throw _notConfigured(defaultTargetPlatform.name);
+    }
+  }
+
+  static UnsupportedError _notConfigured(String platform) {
+    return UnsupportedError(
+      'DefaultFirebaseOptions have not been configured for $platform. '
+      'Run flutterfire configure.',
+    );
+  }
+}
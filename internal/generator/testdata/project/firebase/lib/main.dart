@@ -0,0 +1,26 @@
+import 'dart:async';
+
+import 'package:demo_app/network/service/fcm_notification_service.dart';
+import 'package:demo_app/utils/firebase_initializer.dart';
+import 'package:firebase_crashlytics/firebase_crashlytics.dart';
+import 'package:firebase_messaging/firebase_messaging.dart';
+import 'package:flutter/material.dart';
+import 'package:logger/logger.dart';
+import 'app.dart';
+
+Future<void> main() async {
+  // Binding, backend initialization and runApp share the error zone
+  await runZonedGuarded(() async {
+    WidgetsFlutterBinding.ensureInitialized();
+    await FirebaseInitializer.initialize();
+    FirebaseMessaging.onBackgroundMessage(firebaseMessagingBackgroundHandler);
+    FlutterError.onError = FirebaseCrashlytics.instance.recordFlutterFatalError;
+    WidgetsBinding.instance.platformDispatcher.onError = (error, stack) {
+      FirebaseCrashlytics.instance.recordError(error, stack, fatal: true);
+      return true;
+    };
+    runApp(const App());
+  }, (error, stack) {
+    FirebaseCrashlytics.instance.recordError(error, stack, fatal: true);
+  });
+}
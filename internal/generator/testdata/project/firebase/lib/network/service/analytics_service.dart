@@ -0,0 +1,54 @@
+import 'package:firebase_analytics/firebase_analytics.dart';
+import 'package:logger/logger.dart';
+
+class AnalyticsService {
+  final FirebaseAnalytics _analytics;
+  final Logger _logger;
+
+  AnalyticsService({
+    required FirebaseAnalytics analytics,
+    required Logger logger,
+  })  : _analytics = analytics,
+        _logger = logger;
+
+  // Navigator observer logging screen views, e.g. for the router
+  FirebaseAnalyticsObserver get observer =>
+      FirebaseAnalyticsObserver(analytics: _analytics);
+
+  // Log a custom event
+  Future<void> logEvent(String name, {Map<String, Object>? parameters}) async {
+    try {
+      await _analytics.logEvent(name: name, parameters: parameters);
+    } catch (e) {
+      // Analytics must never break the app
+      _logger.w('Analytics error', error: e);
+    }
+  }
+
+  // Log a screen view
+  Future<void> logScreenView(String screenName) async {
+    try {
+      await _analytics.logScreenView(screenName: screenName);
+    } catch (e) {
+      _logger.w('Analytics error', error: e);
+    }
+  }
+
+  // Associate the events with a user, null after sign out
+  Future<void> setUserId(String? id) async {
+    try {
+      await _analytics.setUserId(id: id);
+    } catch (e) {
+      _logger.w('Analytics error', error: e);
+    }
+  }
+
+  // Log a sign in
+  Future<void> logLogin({String? method}) async {
+    try {
+      await _analytics.logLogin(loginMethod: method);
+    } catch (e) {
+      _logger.w('Analytics error', error: e);
+    }
+  }
+}
@@ -0,0 +1,105 @@
+import 'package:firebase_auth/firebase_auth.dart';
+import 'package:google_sign_in/google_sign_in.dart';
+import 'package:logger/logger.dart';
+
+class AuthService {
+  final FirebaseAuth _auth;
+  final Logger _logger;
+
+  // GoogleSignIn must be initialized once, before its first use
+  Future<void>? _googleInitialization;
+
+  AuthService({
+    required FirebaseAuth auth,
+    required Logger logger,
+  })  : _auth = auth,
+        _logger = logger;
+
+  // Get current user
+  User? get currentUser => _auth.currentUser;
+
+  // Auth state changes
+  Stream<User?> get authStateChanges => _auth.authStateChanges();
+
+  // Sign in with email and password
+  Future<UserCredential> signInWithEmailAndPassword({
+    required String email,
+    required String password,
+  }) async {
+    try {
+      return await _auth.signInWithEmailAndPassword(
+        email: email,
+        password: password,
+      );
+    } catch (e) {
+      _logger.e('Sign in error', error: e);
+      rethrow;
+    }
+  }
+
+  // Register with email and password
+  Future<UserCredential> registerWithEmailAndPassword({
+    required String email,
+    required String password,
+  }) async {
+    try {
+      return await _auth.createUserWithEmailAndPassword(
+        email: email,
+        password: password,
+      );
+    } catch (e) {
+      _logger.e('Registration error', error: e);
+      rethrow;
+    }
+  }
+
+  // Sign in with the Google account picked on the device
+  Future<UserCredential> signInWithGoogle() async {
+    try {
+      final account = await (await _google()).authenticate();
+      final credential = GoogleAuthProvider.credential(
+        idToken: account.authentication.idToken,
+      );
+      return await _auth.signInWithCredential(credential);
+    } catch (e) {
+      _logger.e('Google sign in error', error: e);
+      rethrow;
+    }
+  }
+
+  // Sign in with Apple, natively on iOS and on the Apple page elsewhere
+  Future<UserCredential> signInWithApple() async {
+    try {
+      return await _auth.signInWithProvider(AppleAuthProvider());
+    } catch (e) {
+      _logger.e('Apple sign in error', error: e);
+      rethrow;
+    }
+  }
+
+  // Sign out
+  Future<void> signOut() async {
+    try {
+      await _auth.signOut();
+      await (await _google()).signOut();
+    } catch (e) {
+      _logger.e('Sign out error', error: e);
+      rethrow;
+    }
+  }
+
+  // Reset password
+  Future<void> resetPassword(String email) async {
+    try {
+      await _auth.sendPasswordResetEmail(email: email);
+    } catch (e) {
+      _logger.e('Password reset error', error: e);
+      rethrow;
+    }
+  }
+
+  Future<GoogleSignIn> _google() async {
+    await (_googleInitialization ??= GoogleSignIn.instance.initialize());
+    return GoogleSignIn.instance;
+  }
+}
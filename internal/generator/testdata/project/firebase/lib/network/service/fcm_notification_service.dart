@@ -0,0 +1,106 @@
+import 'dart:async';
+
+import 'package:firebase_messaging/firebase_messaging.dart';
+import 'package:logger/logger.dart';
+import 'package:demo_app/network/service/local_notification_service.dart';
+import 'package:demo_app/network/service/notification_service.dart';
+
+// Handles messages received while the app is in the background or
+// terminated; the system shows their notification. It runs in its own
+// isolate, so it must be a top-level function.
+@pragma('vm:entry-point')
+Future<void> firebaseMessagingBackgroundHandler(RemoteMessage message) async {}
+
+// Push notifications through Firebase Cloud Messaging. Messages received in
+// the foreground are shown with local notifications.
+class FcmNotificationService implements NotificationService {
+  final FirebaseMessaging _messaging;
+  final LocalNotificationService _local;
+  final Logger _logger;
+  final _taps = StreamController<NotificationMessage>.broadcast();
+  final _subscriptions = <StreamSubscription<dynamic>>[];
+
+  FcmNotificationService({
+    required FirebaseMessaging messaging,
+    required LocalNotificationService local,
+    required Logger logger,
+  })  : _messaging = messaging,
+        _local = local,
+        _logger = logger;
+
+  @override
+  Stream<NotificationMessage> get onMessage =>
+      FirebaseMessaging.onMessage.map(_toMessage);
+
+  @override
+  Stream<NotificationMessage> get onTap => _taps.stream;
+
+  // Token changes, to be sent to your backend
+  Stream<String> get onTokenRefresh => _messaging.onTokenRefresh;
+
+  @override
+  Future<void> initialize() async {
+    try {
+      _subscriptions.addAll([
+        FirebaseMessaging.onMessage.listen(_showInForeground),
+        FirebaseMessaging.onMessageOpenedApp
+            .listen((message) => _taps.add(_toMessage(message))),
+        _local.onTap.listen(_taps.add),
+      ]);
+      await _local.initialize();
+
+      final initial = await _messaging.getInitialMessage();
+      if (initial != null) {
+        _taps.add(_toMessage(initial));
+      }
+    } catch (e) {
+      _logger.e('Notification initialization error', error: e);
+      rethrow;
+    }
+  }
+
+  @override
+  Future<bool> requestPermission() async {
+    try {
+      final settings = await _messaging.requestPermission();
+      return settings.authorizationStatus == AuthorizationStatus.authorized ||
+          settings.authorizationStatus == AuthorizationStatus.provisional;
+    } catch (e) {
+      _logger.e('Notification permission error', error: e);
+      rethrow;
+    }
+  }
+
+  @override
+  Future<String?> getToken() async {
+    try {
+      return await _messaging.getToken();
+    } catch (e) {
+      _logger.e('Notification token error', error: e);
+      rethrow;
+    }
+  }
+
+  @override
+  Future<void> dispose() async {
+    for (final subscription in _subscriptions) {
+      await subscription.cancel();
+    }
+    await _taps.close();
+    await _local.dispose();
+  }
+
+  // Data-only messages have nothing to show
+  Future<void> _showInForeground(RemoteMessage message) async {
+    if (message.notification == null) {
+      return;
+    }
+    await _local.show(_toMessage(message));
+  }
+
+  NotificationMessage _toMessage(RemoteMessage message) => NotificationMessage(
+        title: message.notification?.title,
+        body: message.notification?.body,
+        data: message.data,
+      );
+}
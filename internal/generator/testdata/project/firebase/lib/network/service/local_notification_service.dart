@@ -0,0 +1,134 @@
+import 'dart:async';
+import 'dart:convert';
+
+import 'package:flutter_local_notifications/flutter_local_notifications.dart';
+import 'package:logger/logger.dart';
+import 'package:demo_app/network/service/notification_service.dart';
+
+// Notifications shown by the app itself, without a push provider
+class LocalNotificationService implements NotificationService {
+  static const _channel = AndroidNotificationChannel(
+    'default',
+    'Notifications',
+    importance: Importance.high,
+  );
+
+  final FlutterLocalNotificationsPlugin _plugin;
+  final Logger _logger;
+  final _messages = StreamController<NotificationMessage>.broadcast();
+  final _taps = StreamController<NotificationMessage>.broadcast();
+  var _nextId = 0;
+
+  LocalNotificationService({
+    required Logger logger,
+    FlutterLocalNotificationsPlugin? plugin,
+  })  : _plugin = plugin ?? FlutterLocalNotificationsPlugin(),
+        _logger = logger;
+
+  @override
+  Stream<NotificationMessage> get onMessage => _messages.stream;
+
+  @override
+  Stream<NotificationMessage> get onTap => _taps.stream;
+
+  @override
+  Future<void> initialize() async {
+    try {
+      await _plugin.initialize(
+        const InitializationSettings(
+          android: AndroidInitializationSettings('@mipmap/ic_launcher'),
+          // Permissions are asked by requestPermission
+          iOS: DarwinInitializationSettings(
+            requestAlertPermission: false,
+            requestBadgePermission: false,
+            requestSoundPermission: false,
+          ),
+        ),
+        onDidReceiveNotificationResponse: (response) =>
+            _taps.add(_decode(response.payload)),
+      );
+      await _plugin
+          .resolvePlatformSpecificImplementation<
+              AndroidFlutterLocalNotificationsPlugin>()
+          ?.createNotificationChannel(_channel);
+
+      final launch = await _plugin.getNotificationAppLaunchDetails();
+      if (launch != null && launch.didNotificationLaunchApp) {
+        _taps.add(_decode(launch.notificationResponse?.payload));
+      }
+    } catch (e) {
+      _logger.e('Local notifications initialization error', error: e);
+      rethrow;
+    }
+  }
+
+  @override
+  Future<bool> requestPermission() async {
+    try {
+      final android = _plugin.resolvePlatformSpecificImplementation<
+          AndroidFlutterLocalNotificationsPlugin>();
+      if (android != null) {
+        return await android.requestNotificationsPermission() ?? false;
+      }
+      final ios = _plugin.resolvePlatformSpecificImplementation<
+          IOSFlutterLocalNotificationsPlugin>();
+      return await ios?.requestPermissions(
+            alert: true,
+            badge: true,
+            sound: true,
+          ) ??
+          false;
+    } catch (e) {
+      _logger.e('Notification permission error', error: e);
+      rethrow;
+    }
+  }
+
+  @override
+  Future<String?> getToken() async => null;
+
+  // Show a notification now. Its data comes back with the tap.
+  Future<void> show(NotificationMessage message) async {
+    try {
+      await _plugin.show(
+        _nextId++,
+        message.title,
+        message.body,
+        NotificationDetails(
+          android: AndroidNotificationDetails(
+            _channel.id,
+            _channel.name,
+            importance: Importance.high,
+            priority: Priority.high,
+          ),
+          iOS: const DarwinNotificationDetails(),
+        ),
+        payload: jsonEncode(message.data),
+      );
+      _messages.add(message);
+    } catch (e) {
+      _logger.e('Show notification error', error: e);
+      rethrow;
+    }
+  }
+
+  @override
+  Future<void> dispose() async {
+    await _messages.close();
+    await _taps.close();
+  }
+
+  NotificationMessage _decode(String? payload) {
+    if (payload == null || payload.isEmpty) {
+      return const NotificationMessage();
+    }
+    try {
+      return NotificationMessage(
+        data: Map<String, dynamic>.from(jsonDecode(payload) as Map),
+      );
+    } catch (e) {
+      _logger.w('Invalid notification payload', error: e);
+      return const NotificationMessage();
+    }
+  }
+}
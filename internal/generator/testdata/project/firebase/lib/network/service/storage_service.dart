@@ -0,0 +1,68 @@
+import 'dart:io';
+import 'dart:typed_data';
+
+import 'package:firebase_storage/firebase_storage.dart';
+import 'package:logger/logger.dart';
+
+class StorageService {
+  final FirebaseStorage _storage;
+  final Logger _logger;
+
+  StorageService({
+    required FirebaseStorage storage,
+    required Logger logger,
+  })  : _storage = storage,
+        _logger = logger;
+
+  // Upload a file and return its download URL
+  Future<String> uploadFile(
+    String path,
+    File file, {
+    String? contentType,
+  }) async {
+    try {
+      final ref = _storage.ref(path);
+      await ref.putFile(file, SettableMetadata(contentType: contentType));
+      return await ref.getDownloadURL();
+    } catch (e) {
+      _logger.e('Upload error', error: e);
+      rethrow;
+    }
+  }
+
+  // Upload raw bytes (e.g. a picked image on web) and return the download URL
+  Future<String> uploadData(
+    String path,
+    Uint8List data, {
+    String? contentType,
+  }) async {
+    try {
+      final ref = _storage.ref(path);
+      await ref.putData(data, SettableMetadata(contentType: contentType));
+      return await ref.getDownloadURL();
+    } catch (e) {
+      _logger.e('Upload error', error: e);
+      rethrow;
+    }
+  }
+
+  // Get the download URL of an uploaded file
+  Future<String> getDownloadUrl(String path) async {
+    try {
+      return await _storage.ref(path).getDownloadURL();
+    } catch (e) {
+      _logger.e('Download URL error', error: e);
+      rethrow;
+    }
+  }
+
+  // Delete an uploaded file
+  Future<void> delete(String path) async {
+    try {
+      await _storage.ref(path).delete();
+    } catch (e) {
+      _logger.e('Delete error', error: e);
+      rethrow;
+    }
+  }
+}
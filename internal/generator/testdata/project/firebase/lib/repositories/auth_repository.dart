@@ -0,0 +1,125 @@
+import 'package:firebase_auth/firebase_auth.dart';
+import 'package:google_sign_in/google_sign_in.dart';
+import 'package:logger/logger.dart';
+import 'package:demo_app/network/service/auth_service.dart';
+
+// Reasons an authentication request fails, shown to the user
+enum AuthErrorCode {
+  invalidCredentials,
+  emailAlreadyInUse,
+  weakPassword,
+  invalidEmail,
+  tooManyRequests,
+  network,
+  cancelled,
+  unknown,
+}
+
+enum SocialProvider {
+  google,
+  apple,
+}
+
+class AuthFailure implements Exception {
+  final AuthErrorCode code;
+
+  const AuthFailure(this.code);
+
+  @override
+  String toString() => 'AuthFailure($code)';
+}
+
+class AuthRepository {
+  final AuthService _service;
+  final Logger _logger;
+
+  AuthRepository({
+    required AuthService service,
+    required Logger logger,
+  })  : _service = service,
+        _logger = logger;
+
+  bool get isSignedIn => _service.currentUser != null;
+
+  // Emits whether a user is signed in, on every change
+  Stream<bool> get signedInChanges =>
+      _service.authStateChanges.map((user) => user != null);
+
+  // Waits for Firebase to restore the persisted user
+  Future<bool> restoreSession() => _guard('Session restore', () async {
+        final user = await _service.authStateChanges.first;
+        return user != null;
+      });
+
+  Future<void> signIn({
+    required String email,
+    required String password,
+  }) =>
+      _guard('Sign in', () async {
+        await _service.signInWithEmailAndPassword(
+          email: email,
+          password: password,
+        );
+      });
+
+  // Returns whether the user is signed in
+  Future<bool> signInWith(SocialProvider provider) =>
+      _guard('${provider.name} sign in', () async {
+        await switch (provider) {
+          SocialProvider.google => _service.signInWithGoogle(),
+          SocialProvider.apple => _service.signInWithApple(),
+        };
+        return true;
+      });
+
+  // Returns whether the new user is signed in
+  Future<bool> signUp({
+    required String email,
+    required String password,
+  }) =>
+      _guard('Sign up', () async {
+        await _service.registerWithEmailAndPassword(
+          email: email,
+          password: password,
+        );
+        return true;
+      });
+
+  Future<void> resetPassword(String email) =>
+      _guard('Password reset', () => _service.resetPassword(email));
+
+  Future<void> signOut() => _guard('Sign out', _service.signOut);
+
+  Future<T> _guard<T>(String action, Future<T> Function() request) async {
+    try {
+      return await request();
+    } on FirebaseAuthException catch (e) {
+      _logger.e('$action error', error: e);
+      throw AuthFailure(_code(e.code));
+    } on GoogleSignInException catch (e) {
+      _logger.e('$action error', error: e);
+      throw AuthFailure(e.code == GoogleSignInExceptionCode.canceled
+          ? AuthErrorCode.cancelled
+          : AuthErrorCode.unknown);
+    } catch (e) {
+      _logger.e('$action error', error: e);
+      throw const AuthFailure(AuthErrorCode.unknown);
+    }
+  }
+
+  AuthErrorCode _code(String code) => switch (code) {
+        'invalid-credential' ||
+        'wrong-password' ||
+        'user-not-found' =>
+          AuthErrorCode.invalidCredentials,
+        'email-already-in-use' => AuthErrorCode.emailAlreadyInUse,
+        'weak-password' => AuthErrorCode.weakPassword,
+        'invalid-email' => AuthErrorCode.invalidEmail,
+        'too-many-requests' => AuthErrorCode.tooManyRequests,
+        'network-request-failed' => AuthErrorCode.network,
+        'account-exists-with-different-credential' =>
+          AuthErrorCode.emailAlreadyInUse,
+        'web-context-canceled' || 'canceled' => AuthErrorCode.cancelled,
+        _ => AuthErrorCode.unknown,
+      };
+}
@@ -0,0 +1,68 @@
+import 'package:logger/logger.dart';
+import 'package:demo_app/model/user.dart';
+import 'package:demo_app/network/service/user_service.dart';
+
+class UserRepository {
+  final UserService _service;
+  final Logger _logger;
+
+  UserRepository({
+    required UserService service,
+    required Logger logger,
+  })  : _service = service,
+        _logger = logger;
+
+  Future<List<User>> getAll() async {
+    try {
+      return await _service.getAll();
+    } catch (e) {
+      _logger.e('Error fetching Users', error: e);
+      rethrow;
+    }
+  }
+
+  Future<List<User>> getPage(int page, int limit) async {
+    try {
+      return await _service.getPage(page, limit);
+    } catch (e) {
+      _logger.e('Error fetching page $page of Users', error: e);
+      rethrow;
+    }
+  }
+
+  Future<User> getById(String id) async {
+    try {
+      return await _service.getById(id);
+    } catch (e) {
+      _logger.e('Error fetching User', error: e);
+      rethrow;
+    }
+  }
+
+  Future<User> create(User user) async {
+    try {
+      return await _service.create(user);
+    } catch (e) {
+      _logger.e('Error creating User', error: e);
+      rethrow;
+    }
+  }
+
+  Future<User> update(String id, User user) async {
+    try {
+      return await _service.update(id, user);
+    } catch (e) {
+      _logger.e('Error updating User', error: e);
+      rethrow;
+    }
+  }
+
+  Future<void> delete(String id) async {
+    try {
+      await _service.delete(id);
+    } catch (e) {
+      _logger.e('Error deleting User', error: e);
+      rethrow;
+    }
+  }
+}
@@ -0,0 +1,21 @@
+import 'package:auto_route/auto_route.dart';
+import 'package:demo_app/routers/app_router.gr.dart';
+import 'package:demo_app/routers/auth_guard.dart';
+
+@AutoRouterConfig(
+  replaceInRouteName: 'Page,Route',
+)
+class AppRouter extends RootStackRouter {
+  final _authGuard = AuthGuard();
+
+  @override
+  List<AutoRoute> get routes => [
+        AutoRoute(page: SplashRoute.page, initial: true),
+        AutoRoute(page: LoginRoute.page),
+        AutoRoute(page: SignUpRoute.page),
+        AutoRoute(page: ForgotPasswordRoute.page),
+        AutoRoute(page: HomeRoute.page, guards: [_authGuard]),
+        AutoRoute(page: ProfileRoute.page, guards: [_authGuard]),
+        AutoRoute(page: SettingsRoute.page, guards: [_authGuard]),
+      ];
+}
@@ -0,0 +1,48 @@
+part of 'auth_bloc.dart';
+
+abstract class AuthEvent extends Equatable {
+  const AuthEvent();
+
+  @override
+  List<Object> get props => [];
+}
+
+class SignIn extends AuthEvent {
+  final String email;
+  final String password;
+
+  const SignIn({required this.email, required this.password});
+
+  @override
+  List<Object> get props => [email, password];
+}
+
+class SignInWith extends AuthEvent {
+  final SocialProvider provider;
+
+  const SignInWith(this.provider);
+
+  @override
+  List<Object> get props => [provider];
+}
+
+class SignUp extends AuthEvent {
+  final String email;
+  final String password;
+
+  const SignUp({required this.email, required this.password});
+
+  @override
+  List<Object> get props => [email, password];
+}
+
+class ResetPassword extends AuthEvent {
+  final String email;
+
+  const ResetPassword({required this.email});
+
+  @override
+  List<Object> get props => [email];
+}
+
+class SignOut extends AuthEvent {}
@@ -0,0 +1,30 @@
+part of 'auth_bloc.dart';
+
+abstract class AuthState extends Equatable {
+  const AuthState();
+
+  @override
+  List<Object> get props => [];
+}
+
+class AuthInitial extends AuthState {}
+
+class AuthLoading extends AuthState {}
+
+class Authenticated extends AuthState {}
+
+class Unauthenticated extends AuthState {}
+
+// The account must be confirmed from the email before signing in
+class SignUpConfirmationSent extends AuthState {}
+
+class PasswordResetSent extends AuthState {}
+
+class AuthError extends AuthState {
+  final AuthErrorCode code;
+
+  const AuthError(this.code);
+
+  @override
+  List<Object> get props => [code];
+}
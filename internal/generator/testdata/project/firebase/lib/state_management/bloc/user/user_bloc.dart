@@ -0,0 +1,113 @@
+import 'package:flutter_bloc/flutter_bloc.dart';
+import 'package:equatable/equatable.dart';
+import 'package:demo_app/repositories/user_repository.dart';
+import 'package:demo_app/model/user.dart';
+
+part 'user_event.dart';
+part 'user_state.dart';
+
+class UserBloc extends Bloc<UserEvent, UserState> {
+  /// Items per page of FetchUsers
+  static const pageSize = 20;
+
+  final UserRepository _repository;
+
+  UserBloc({required UserRepository repository})
+      : _repository = repository,
+        super(UserInitial()) {
+    on<FetchUsers>(_onFetchUsers);
+    on<FetchUser>(_onFetchUser);
+    on<CreateUser>(_onCreateUser);
+    on<UpdateUser>(_onUpdateUser);
+    on<DeleteUser>(_onDeleteUser);
+  }
+
+  Future<void> _onFetchUsers(
+    FetchUsers event,
+    Emitter<UserState> emit,
+  ) async {
+    var items = const <User>[];
+    final current = state;
+    if (event.page > 1) {
+      // Pages are appended in order, one at a time
+      if (current is! UsersLoaded ||
+          !current.hasMore ||
+          current.loadingMore ||
+          event.page != current.page + 1) {
+        return;
+      }
+      items = current.items;
+      emit(UsersLoaded(
+        items,
+        page: current.page,
+        hasMore: true,
+        loadingMore: true,
+      ));
+    } else {
+      emit(UserLoading());
+    }
+
+    try {
+      final loaded = await _repository.getPage(event.page, event.limit);
+      emit(UsersLoaded(
+        [...items, ...loaded],
+        page: event.page,
+        hasMore: loaded.length == event.limit,
+      ));
+    } catch (e) {
+      emit(UserError(e.toString()));
+    }
+  }
+
+  Future<void> _onFetchUser(
+    FetchUser event,
+    Emitter<UserState> emit,
+  ) async {
+    emit(UserLoading());
+    try {
+      final item = await _repository.getById(event.id);
+      emit(UserLoaded(item));
+    } catch (e) {
+      emit(UserError(e.toString()));
+    }
+  }
+
+  Future<void> _onCreateUser(
+    CreateUser event,
+    Emitter<UserState> emit,
+  ) async {
+    emit(UserLoading());
+    try {
+      await _repository.create(event.user);
+      emit(UserCreated());
+    } catch (e) {
+      emit(UserError(e.toString()));
+    }
+  }
+
+  Future<void> _onUpdateUser(
+    UpdateUser event,
+    Emitter<UserState> emit,
+  ) async {
+    emit(UserLoading());
+    try {
+      await _repository.update(event.id, event.user);
+      emit(UserUpdated());
+    } catch (e) {
+      emit(UserError(e.toString()));
+    }
+  }
+
+  Future<void> _onDeleteUser(
+    DeleteUser event,
+    Emitter<UserState> emit,
+  ) async {
+    emit(UserLoading());
+    try {
+      await _repository.delete(event.id);
+      emit(UserDeleted());
+    } catch (e) {
+      emit(UserError(e.toString()));
+    }
+  }
+}
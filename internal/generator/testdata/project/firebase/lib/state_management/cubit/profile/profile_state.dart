@@ -0,0 +1,37 @@
+part of 'profile_cubit.dart';
+
+// Missing details are null
+class ProfileState extends Equatable {
+  final String? name;
+  final String? email;
+  final String? phone;
+  final String? location;
+  final DateTime? birthday;
+
+  const ProfileState({
+    this.name,
+    this.email,
+    this.phone,
+    this.location,
+    this.birthday,
+  });
+
+  ProfileState copyWith({
+    String? name,
+    String? email,
+    String? phone,
+    String? location,
+    DateTime? birthday,
+  }) {
+    return ProfileState(
+      name: name ?? this.name,
+      email: email ?? this.email,
+      phone: phone ?? this.phone,
+      location: location ?? this.location,
+      birthday: birthday ?? this.birthday,
+    );
+  }
+
+  @override
+  List<Object?> get props => [name, email, phone, location, birthday];
+}
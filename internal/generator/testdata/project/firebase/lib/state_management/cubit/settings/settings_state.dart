@@ -0,0 +1,32 @@
+part of 'settings_cubit.dart';
+
+class SettingsState extends Equatable {
+  final bool notificationsEnabled;
+  final bool darkModeEnabled;
+  final bool autoSyncEnabled;
+
+  const SettingsState({
+    this.notificationsEnabled = true,
+    this.darkModeEnabled = false,
+    this.autoSyncEnabled = true,
+  });
+
+  SettingsState copyWith({
+    bool? notificationsEnabled,
+    bool? darkModeEnabled,
+    bool? autoSyncEnabled,
+  }) {
+    return SettingsState(
+      notificationsEnabled: notificationsEnabled ?? this.notificationsEnabled,
+      darkModeEnabled: darkModeEnabled ?? this.darkModeEnabled,
+      autoSyncEnabled: autoSyncEnabled ?? this.autoSyncEnabled,
+    );
+  }
+
+  @override
+  List<Object> get props => [
+        notificationsEnabled,
+        darkModeEnabled,
+        autoSyncEnabled,
+      ];
+}
@@ -0,0 +1,46 @@
+import 'package:auto_route/auto_route.dart';
+import 'package:flutter/material.dart';
+import 'package:flutter_bloc/flutter_bloc.dart';
+import 'package:demo_app/l10n/app_localizations.dart';
+import 'package:demo_app/repositories/auth_repository.dart';
+import 'package:demo_app/state_management/bloc/auth/auth_bloc.dart';
+import 'package:demo_app/ui/forgot_password/widgets/forgot_password_form.dart';
+import 'package:demo_app/utils/auth_error_messages.dart';
+
+@RoutePage()
+class ForgotPasswordPage extends StatelessWidget {
+  const ForgotPasswordPage({super.key});
+
+  @override
+  Widget build(BuildContext context) {
+    return BlocProvider(
+      create: (context) => AuthBloc(repository: context.read<AuthRepository>()),
+      child: BlocListener<AuthBloc, AuthState>(
+        listener: (context, state) {
+          final l10n = AppLocalizations.of(context)!;
+          if (state is PasswordResetSent) {
+            ScaffoldMessenger.of(context).showSnackBar(
+              SnackBar(content: Text(l10n.passwordResetSent)),
+            );
+            context.router.maybePop();
+          } else if (state is AuthError) {
+            ScaffoldMessenger.of(context).showSnackBar(
+              SnackBar(content: Text(state.code.message(l10n))),
+            );
+          }
+        },
+        child: Scaffold(
+          appBar: AppBar(),
+          body: const SafeArea(
+            child: Center(
+              child: SingleChildScrollView(
+                padding: EdgeInsets.all(24),
+                child: ForgotPasswordForm(),
+              ),
+            ),
+          ),
+        ),
+      ),
+    );
+  }
+}
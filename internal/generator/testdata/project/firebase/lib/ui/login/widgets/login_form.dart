@@ -0,0 +1,90 @@
+import 'package:auto_route/auto_route.dart';
+import 'package:flutter/material.dart';
+import 'package:flutter_bloc/flutter_bloc.dart';
+import 'package:demo_app/l10n/app_localizations.dart';
+import 'package:demo_app/routers/app_router.gr.dart';
+import 'package:demo_app/state_management/bloc/auth/auth_bloc.dart';
+import 'package:demo_app/ui/shared/widgets/auth_header.dart';
+import 'package:demo_app/ui/shared/widgets/auth_submit_button.dart';
+import 'package:demo_app/ui/shared/widgets/email_field.dart';
+import 'package:demo_app/ui/shared/widgets/password_field.dart';
+import 'package:demo_app/ui/shared/widgets/social_sign_in_buttons.dart';
+
+// The form keeps its controllers only, the request state is in AuthBloc
+class LoginForm extends StatefulWidget {
+  const LoginForm({super.key});
+
+  @override
+  State<LoginForm> createState() => _LoginFormState();
+}
+
+class _LoginFormState extends State<LoginForm> {
+  final _formKey = GlobalKey<FormState>();
+  final _emailController = TextEditingController();
+  final _passwordController = TextEditingController();
+
+  @override
+  void dispose() {
+    _emailController.dispose();
+    _passwordController.dispose();
+    super.dispose();
+  }
+
+  void _submit() {
+    if (!(_formKey.currentState?.validate() ?? false)) {
+      return;
+    }
+    context.read<AuthBloc>().add(SignIn(
+          email: _emailController.text.trim(),
+          password: _passwordController.text,
+        ));
+  }
+
+  @override
+  Widget build(BuildContext context) {
+    final l10n = AppLocalizations.of(context)!;
+
+    return Form(
+      key: _formKey,
+      child: AutofillGroup(
+        child: Column(
+          crossAxisAlignment: CrossAxisAlignment.stretch,
+          children: [
+            AuthHeader(
+              icon: Icons.lock_outline,
+              title: l10n.loginTitle,
+              subtitle: l10n.loginSubtitle,
+            ),
+            const SizedBox(height: 48),
+            EmailField(controller: _emailController),
+            const SizedBox(height: 16),
+            PasswordField(
+              controller: _passwordController,
+              onSubmitted: (_) => _submit(),
+            ),
+            const SizedBox(height: 24),
+            AuthSubmitButton(label: l10n.signInButton, onPressed: _submit),
+            const SizedBox(height: 16),
+            TextButton(
+              onPressed: () => context.router.push(const ForgotPasswordRoute()),
+              child: Text(l10n.forgotPasswordButton),
+            ),
+            const SizedBox(height: 16),
+            const SocialSignInButtons(),
+            const SizedBox(height: 24),
+            Row(
+              mainAxisAlignment: MainAxisAlignment.center,
+              children: [
+                Text(l10n.noAccountPrompt),
+                TextButton(
+                  onPressed: () => context.router.push(const SignUpRoute()),
+                  child: Text(l10n.signUpButton),
+                ),
+              ],
+            ),
+          ],
+        ),
+      ),
+    );
+  }
+}
@@ -0,0 +1,45 @@
+import 'package:flutter/material.dart';
+import 'package:demo_app/l10n/app_localizations.dart';
+
+// Links to the account management at the bottom of the profile screen
+class AccountActions extends StatelessWidget {
+  const AccountActions({super.key});
+
+  @override
+  Widget build(BuildContext context) {
+    final l10n = AppLocalizations.of(context)!;
+    final theme = Theme.of(context);
+
+    return Column(
+      children: [
+        ListTile(
+          leading: const Icon(Icons.security),
+          title: Text(l10n.changePassword),
+          trailing: const Icon(Icons.chevron_right),
+          onTap: () {
+            // TODO: Navigate to change password
+          },
+        ),
+        ListTile(
+          leading: const Icon(Icons.privacy_tip),
+          title: Text(l10n.privacySettings),
+          trailing: const Icon(Icons.chevron_right),
+          onTap: () {
+            // TODO: Navigate to privacy settings
+          },
+        ),
+        ListTile(
+          leading: Icon(Icons.delete_forever, color: theme.colorScheme.error),
+          title: Text(
+            l10n.deleteAccount,
+            style: TextStyle(color: theme.colorScheme.error),
+          ),
+          trailing: const Icon(Icons.chevron_right),
+          onTap: () {
+            // TODO: Show delete confirmation
+          },
+        ),
+      ],
+    );
+  }
+}
@@ -0,0 +1,57 @@
+import 'package:flutter/material.dart';
+
+// Asks for a new value of a profile detail, popping it when saved
+class EditProfileFieldDialog extends StatefulWidget {
+  final String title;
+  final String? initialValue;
+
+  const EditProfileFieldDialog({
+    super.key,
+    required this.title,
+    this.initialValue,
+  });
+
+  @override
+  State<EditProfileFieldDialog> createState() => _EditProfileFieldDialogState();
+}
+
+// The dialog keeps its controller only, the value is saved by ProfileCubit
+class _EditProfileFieldDialogState extends State<EditProfileFieldDialog> {
+  late final _controller = TextEditingController(text: widget.initialValue);
+
+  @override
+  void dispose() {
+    _controller.dispose();
+    super.dispose();
+  }
+
+  void _save() {
+    final value = _controller.text.trim();
+    Navigator.of(context).pop(value.isEmpty ? null : value);
+  }
+
+  @override
+  Widget build(BuildContext context) {
+    final labels = MaterialLocalizations.of(context);
+
+    return AlertDialog(
+      title: Text(widget.title),
+      content: TextField(
+        controller: _controller,
+        autofocus: true,
+        textInputAction: TextInputAction.done,
+        onSubmitted: (_) => _save(),
+      ),
+      actions: [
+        TextButton(
+          onPressed: () => Navigator.of(context).pop(),
+          child: Text(labels.cancelButtonLabel),
+        ),
+        TextButton(
+          onPressed: _save,
+          child: Text(labels.saveButtonLabel),
+        ),
+      ],
+    );
+  }
+}
@@ -0,0 +1,29 @@
+import 'package:flutter/material.dart';
+import 'package:demo_app/l10n/app_localizations.dart';
+
+// Profile detail opening its editor when tapped
+class ProfileDetailTile extends StatelessWidget {
+  final IconData icon;
+  final String label;
+  final String? value;
+  final VoidCallback onTap;
+
+  const ProfileDetailTile({
+    super.key,
+    required this.icon,
+    required this.label,
+    required this.value,
+    required this.onTap,
+  });
+
+  @override
+  Widget build(BuildContext context) {
+    return ListTile(
+      leading: Icon(icon),
+      title: Text(label),
+      subtitle: Text(value ?? AppLocalizations.of(context)!.profileNotSet),
+      trailing: const Icon(Icons.edit),
+      onTap: onTap,
+    );
+  }
+}
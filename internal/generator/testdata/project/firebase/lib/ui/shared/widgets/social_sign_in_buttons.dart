@@ -0,0 +1,92 @@
+import 'package:flutter/material.dart';
+import 'package:flutter_bloc/flutter_bloc.dart';
+import 'package:font_awesome_flutter/font_awesome_flutter.dart';
+import 'package:demo_app/l10n/app_localizations.dart';
+import 'package:demo_app/repositories/auth_repository.dart';
+import 'package:demo_app/state_management/bloc/auth/auth_bloc.dart';
+
+// Social sign-in buttons of the login screen, disabled while a request runs
+class SocialSignInButtons extends StatelessWidget {
+  const SocialSignInButtons({super.key});
+
+  @override
+  Widget build(BuildContext context) {
+    final l10n = AppLocalizations.of(context)!;
+
+    return BlocBuilder<AuthBloc, AuthState>(
+      builder: (context, state) {
+        final loading = state is AuthLoading;
+
+        void signInWith(SocialProvider provider) =>
+            context.read<AuthBloc>().add(SignInWith(provider));
+
+        return Column(
+          crossAxisAlignment: CrossAxisAlignment.stretch,
+          children: [
+            _SignInDivider(label: l10n.signInDivider),
+            const SizedBox(height: 12),
+            _SocialButton(
+              icon: FontAwesomeIcons.google,
+              label: l10n.continueWithGoogle,
+              onPressed:
+                  loading ? null : () => signInWith(SocialProvider.google),
+            ),
+            const SizedBox(height: 12),
+            _SocialButton(
+              icon: FontAwesomeIcons.apple,
+              label: l10n.continueWithApple,
+              onPressed:
+                  loading ? null : () => signInWith(SocialProvider.apple),
+            ),
+          ],
+        );
+      },
+    );
+  }
+}
+
+class _SignInDivider extends StatelessWidget {
+  final String label;
+
+  const _SignInDivider({required this.label});
+
+  @override
+  Widget build(BuildContext context) {
+    final theme = Theme.of(context);
+
+    return Row(
+      children: [
+        const Expanded(child: Divider()),
+        Padding(
+          padding: const EdgeInsets.symmetric(horizontal: 16),
+          child: Text(label, style: theme.textTheme.bodySmall),
+        ),
+        const Expanded(child: Divider()),
+      ],
+    );
+  }
+}
+
+class _SocialButton extends StatelessWidget {
+  final IconData icon;
+  final String label;
+  final VoidCallback? onPressed;
+
+  const _SocialButton({
+    required this.icon,
+    required this.label,
+    required this.onPressed,
+  });
+
+  @override
+  Widget build(BuildContext context) {
+    return OutlinedButton.icon(
+      onPressed: onPressed,
+      icon: FaIcon(icon, size: 18),
+      label: Padding(
+        padding: const EdgeInsets.symmetric(vertical: 16),
+        child: Text(label),
+      ),
+    );
+  }
+}
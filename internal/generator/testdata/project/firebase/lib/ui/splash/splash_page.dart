@@ -0,0 +1,35 @@
+import 'package:auto_route/auto_route.dart';
+import 'package:flutter/material.dart';
+import 'package:flutter_bloc/flutter_bloc.dart';
+import 'package:demo_app/repositories/auth_repository.dart';
+import 'package:demo_app/routers/app_router.gr.dart';
+import 'package:demo_app/state_management/cubit/splash/splash_cubit.dart';
+import 'package:demo_app/ui/splash/widgets/splash_view.dart';
+
+// Restores the saved session, then opens the home or the login screen
+@RoutePage()
+class SplashPage extends StatelessWidget {
+  const SplashPage({super.key});
+
+  @override
+  Widget build(BuildContext context) {
+    return BlocProvider(
+      create: (context) => SplashCubit(
+        auth: context.read<AuthRepository>(),
+      )..start(),
+      child: BlocListener<SplashCubit, SplashDestination?>(
+        listener: (context, destination) {
+          final route = switch (destination) {
+            SplashDestination.login => const LoginRoute(),
+            SplashDestination.main => const HomeRoute(),
+            null => null,
+          };
+          if (route != null) {
+            context.router.replaceAll([route]);
+          }
+        },
+        child: const SplashView(),
+      ),
+    );
+  }
+}
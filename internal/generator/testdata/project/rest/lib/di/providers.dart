@@ -0,0 +1,32 @@
+part of 'dependency_injector.dart';
+
+final List<SingleChildWidget> _providers = [
+  Provider<Logger>(create: (_) => Logger()),
+
+  Provider<PrettyDioLogger>(
+      create: (_) => PrettyDioLogger(
+          requestBody: true, compact: true, requestHeader: true)),
+
+  Provider<FlutterSecureStorage>(
+    create: (_) => const FlutterSecureStorage(),
+  ),
+
+  Provider<TokenStore>(
+    create: (context) =>
+        TokenStore(storage: context.read<FlutterSecureStorage>()),
+  ),
+
+  Provider<Dio>(
+      create: (context) => Dio(BaseOptions(baseUrl: ApiConfig.baseUrl))
+        ..interceptors.addAll([
+          RefreshTokenInterceptor(
+            tokens: context.read<TokenStore>(),
+            dio: Dio(BaseOptions(baseUrl: ApiConfig.baseUrl)),
+          ),
+          if (kDebugMode) context.read<PrettyDioLogger>(),
+        ])),
+
+  Provider<AuthService>(
+    create: (context) => AuthService(context.read<Dio>()),
+  ),
+];
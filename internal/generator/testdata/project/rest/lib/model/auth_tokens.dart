@@ -0,0 +1,67 @@
+import 'package:equatable/equatable.dart';
+import 'package:json_annotation/json_annotation.dart';
+
+part 'auth_tokens.g.dart';
+
+// Tokens returned by the login, register and refresh endpoints
+@JsonSerializable(fieldRename: FieldRename.snake)
+class AuthTokens extends Equatable {
+  final String accessToken;
+  final String? refreshToken;
+
+  const AuthTokens({required this.accessToken, this.refreshToken});
+
+  factory AuthTokens.fromJson(Map<String, dynamic> json) =>
+      _$AuthTokensFromJson(json);
+
+  Map<String, dynamic> toJson() => _$AuthTokensToJson(this);
+
+  @override
+  List<Object?> get props => [accessToken, refreshToken];
+}
+
+@JsonSerializable(fieldRename: FieldRename.snake)
+class Credentials extends Equatable {
+  final String email;
+  final String password;
+
+  const Credentials({required this.email, required this.password});
+
+  factory Credentials.fromJson(Map<String, dynamic> json) =>
+      _$CredentialsFromJson(json);
+
+  Map<String, dynamic> toJson() => _$CredentialsToJson(this);
+
+  @override
+  List<Object?> get props => [email, password];
+}
+
+@JsonSerializable(fieldRename: FieldRename.snake)
+class RefreshRequest extends Equatable {
+  final String refreshToken;
+
+  const RefreshRequest({required this.refreshToken});
+
+  factory RefreshRequest.fromJson(Map<String, dynamic> json) =>
+      _$RefreshRequestFromJson(json);
+
+  Map<String, dynamic> toJson() => _$RefreshRequestToJson(this);
+
+  @override
+  List<Object?> get props => [refreshToken];
+}
+
+@JsonSerializable(fieldRename: FieldRename.snake)
+class PasswordResetRequest extends Equatable {
+  final String email;
+
+  const PasswordResetRequest({required this.email});
+
+  factory PasswordResetRequest.fromJson(Map<String, dynamic> json) =>
+      _$PasswordResetRequestFromJson(json);
+
+  Map<String, dynamic> toJson() => _$PasswordResetRequestToJson(this);
+
+  @override
+  List<Object?> get props => [email];
+}
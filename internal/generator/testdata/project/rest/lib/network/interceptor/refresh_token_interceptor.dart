@@ -0,0 +1,75 @@
+import 'package:dio/dio.dart';
+import 'package:demo_app/model/auth_tokens.dart';
+import 'package:demo_app/network/service/auth_service.dart';
+import 'package:demo_app/repositories/token_store.dart';
+
+// Sends the access token with every request. When the API answers 401, the
+// token is renewed with the refresh token and the request is sent again.
+// Requests are queued while a token is being renewed.
+class RefreshTokenInterceptor extends QueuedInterceptor {
+  final TokenStore _tokens;
+  final Dio _dio;
+  final AuthService _service;
+
+  // dio renews the token and retries the requests, so it must not use this
+  // interceptor
+  RefreshTokenInterceptor({
+    required TokenStore tokens,
+    required Dio dio,
+  })  : _tokens = tokens,
+        _dio = dio,
+        _service = AuthService(dio);
+
+  @override
+  Future<void> onRequest(
+    RequestOptions options,
+    RequestInterceptorHandler handler,
+  ) async {
+    final accessToken = await _tokens.accessToken;
+    if (accessToken != null) {
+      options.headers['Authorization'] = 'Bearer $accessToken';
+    }
+    handler.next(options);
+  }
+
+  @override
+  Future<void> onError(
+    DioException err,
+    ErrorInterceptorHandler handler,
+  ) async {
+    final refreshToken = await _tokens.refreshToken;
+    if (err.response?.statusCode != 401 || refreshToken == null) {
+      handler.next(err);
+      return;
+    }
+
+    try {
+      // A request queued behind a refresh already has a new token
+      var accessToken = await _tokens.accessToken;
+      if (accessToken == null ||
+          err.requestOptions.headers['Authorization'] ==
+              'Bearer $accessToken') {
+        final tokens = await _service.refresh(
+          RefreshRequest(refreshToken: refreshToken),
+        );
+        await _tokens.save(
+          accessToken: tokens.accessToken,
+          refreshToken: tokens.refreshToken,
+        );
+        accessToken = tokens.accessToken;
+      }
+      handler.resolve(await _retry(err.requestOptions, accessToken));
+    } on DioException catch (e) {
+      // The refresh token expired as well, the user must sign in again
+      if (e.response?.statusCode == 401) {
+        await _tokens.clear();
+      }
+      handler.next(err);
+    }
+  }
+
+  Future<Response<dynamic>> _retry(RequestOptions options, String accessToken) {
+    options.headers['Authorization'] = 'Bearer $accessToken';
+    return _dio.fetch<dynamic>(options);
+  }
+}
@@ -0,0 +1,26 @@
+import 'package:dio/dio.dart';
+import 'package:retrofit/retrofit.dart';
+import 'package:demo_app/model/auth_tokens.dart';
+
+part 'auth_service.g.dart';
+
+// Authentication endpoints of the REST API
+@RestApi()
+abstract class AuthService {
+  factory AuthService(Dio dio) = _AuthService;
+
+  @POST('/auth/login')
+  Future<AuthTokens> login(@Body() Credentials credentials);
+
+  @POST('/auth/register')
+  Future<AuthTokens> register(@Body() Credentials credentials);
+
+  @POST('/auth/token')
+  Future<AuthTokens> refresh(@Body() RefreshRequest request);
+
+  @POST('/auth/logout')
+  Future<void> logout(@Body() RefreshRequest request);
+
+  @POST('/auth/password-reset')
+  Future<void> resetPassword(@Body() PasswordResetRequest request);
+}
@@ -0,0 +1,129 @@
+import 'dart:async';
+
+import 'package:dio/dio.dart';
+import 'package:logger/logger.dart';
+import 'package:demo_app/model/auth_tokens.dart';
+import 'package:demo_app/network/service/auth_service.dart';
+import 'package:demo_app/repositories/token_store.dart';
+
+// Reasons an authentication request fails, shown to the user
+enum AuthErrorCode {
+  invalidCredentials,
+  emailAlreadyInUse,
+  weakPassword,
+  invalidEmail,
+  tooManyRequests,
+  network,
+  unknown,
+}
+
+class AuthFailure implements Exception {
+  final AuthErrorCode code;
+
+  const AuthFailure(this.code);
+
+  @override
+  String toString() => 'AuthFailure($code)';
+}
+
+class AuthRepository {
+  final AuthService _service;
+  final TokenStore _tokens;
+  final Logger _logger;
+  final _signedInChanges = StreamController<bool>.broadcast();
+  bool _signedIn = false;
+
+  AuthRepository({
+    required AuthService service,
+    required TokenStore tokens,
+    required Logger logger,
+  })  : _service = service,
+        _tokens = tokens,
+        _logger = logger;
+
+  bool get isSignedIn => _signedIn;
+
+  // Emits whether a user is signed in, on every change
+  Stream<bool> get signedInChanges => _signedInChanges.stream;
+
+  // The session is the access token kept in the secure storage,
+  // RefreshTokenInterceptor renews it when it expires
+  Future<bool> restoreSession() async {
+    _setSignedIn(await _tokens.accessToken != null);
+    return _signedIn;
+  }
+
+  Future<void> signIn({
+    required String email,
+    required String password,
+  }) =>
+      _guard('Sign in', () async {
+        await _save(await _service.login(
+          Credentials(email: email, password: password),
+        ));
+      });
+
+  // Returns whether the new user is signed in
+  Future<bool> signUp({
+    required String email,
+    required String password,
+  }) =>
+      _guard('Sign up', () async {
+        await _save(await _service.register(
+          Credentials(email: email, password: password),
+        ));
+        return true;
+      });
+
+  Future<void> resetPassword(String email) => _guard('Password reset',
+      () => _service.resetPassword(PasswordResetRequest(email: email)));
+
+  // The tokens are dropped even when the API can't be reached
+  Future<void> signOut() async {
+    final refreshToken = await _tokens.refreshToken;
+    try {
+      if (refreshToken != null) {
+        await _service.logout(RefreshRequest(refreshToken: refreshToken));
+      }
+    } on DioException catch (e) {
+      _logger.w('Sign out error', error: e);
+    }
+    await _tokens.clear();
+    _setSignedIn(false);
+  }
+
+  Future<void> _save(AuthTokens tokens) async {
+    await _tokens.save(
+      accessToken: tokens.accessToken,
+      refreshToken: tokens.refreshToken,
+    );
+    _setSignedIn(true);
+  }
+
+  void _setSignedIn(bool signedIn) {
+    if (signedIn != _signedIn) {
+      _signedIn = signedIn;
+      _signedInChanges.add(signedIn);
+    }
+  }
+
+  Future<T> _guard<T>(String action, Future<T> Function() request) async {
+    try {
+      return await request();
+    } on DioException catch (e) {
+      _logger.e('$action error', error: e);
+      throw AuthFailure(_code(e));
+    } catch (e) {
+      _logger.e('$action error', error: e);
+      throw const AuthFailure(AuthErrorCode.unknown);
+    }
+  }
+
+  AuthErrorCode _code(DioException e) => switch (e.response?.statusCode) {
+        400 || 401 || 403 => AuthErrorCode.invalidCredentials,
+        409 => AuthErrorCode.emailAlreadyInUse,
+        429 => AuthErrorCode.tooManyRequests,
+        null => AuthErrorCode.network,
+        _ => AuthErrorCode.unknown,
+      };
+}
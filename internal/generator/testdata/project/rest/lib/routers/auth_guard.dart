@@ -0,0 +1,19 @@
+import 'package:auto_route/auto_route.dart';
+import 'package:flutter_bloc/flutter_bloc.dart';
+import 'package:demo_app/repositories/auth_repository.dart';
+import 'package:demo_app/routers/app_router.gr.dart';
+
+// Sends signed out users to the login screen. SplashPage restores the
+// session before the first guarded route opens.
+class AuthGuard extends AutoRouteGuard {
+  @override
+  void onNavigation(NavigationResolver resolver, StackRouter router) {
+    // The navigator is below the DependencyInjector
+    final context = router.navigatorKey.currentContext;
+    if (context != null && context.read<AuthRepository>().isSignedIn) {
+      resolver.next();
+      return;
+    }
+    resolver.redirectUntil(const LoginRoute(), replace: true);
+  }
+}
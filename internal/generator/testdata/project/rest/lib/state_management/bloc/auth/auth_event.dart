@@ -0,0 +1,39 @@
+part of 'auth_bloc.dart';
+
+abstract class AuthEvent extends Equatable {
+  const AuthEvent();
+
+  @override
+  List<Object> get props => [];
+}
+
+class SignIn extends AuthEvent {
+  final String email;
+  final String password;
+
+  const SignIn({required this.email, required this.password});
+
+  @override
+  List<Object> get props => [email, password];
+}
+
+class SignUp extends AuthEvent {
+  final String email;
+  final String password;
+
+  const SignUp({required this.email, required this.password});
+
+  @override
+  List<Object> get props => [email, password];
+}
+
+class ResetPassword extends AuthEvent {
+  final String email;
+
+  const ResetPassword({required this.email});
+
+  @override
+  List<Object> get props => [email];
+}
+
+class SignOut extends AuthEvent {}
@@ -0,0 +1,62 @@
+import 'package:auto_route/auto_route.dart';
+import 'package:flutter/material.dart';
+import 'package:flutter_bloc/flutter_bloc.dart';
+import 'package:demo_app/l10n/app_localizations.dart';
+import 'package:demo_app/repositories/auth_repository.dart';
+import 'package:demo_app/routers/app_router.gr.dart';
+import 'package:demo_app/state_management/bloc/auth/auth_bloc.dart';
+import 'package:demo_app/ui/home/widgets/home_drawer.dart';
+import 'package:demo_app/ui/home/widgets/quick_actions.dart';
+import 'package:demo_app/ui/home/widgets/welcome_card.dart';
+
+@RoutePage()
+class HomePage extends StatelessWidget {
+  const HomePage({super.key});
+
+  @override
+  Widget build(BuildContext context) {
+    // The drawer signs out through the AuthBloc
+    return BlocProvider(
+      create: (context) => AuthBloc(repository: context.read<AuthRepository>()),
+      child: BlocListener<AuthBloc, AuthState>(
+        listener: (context, state) {
+          if (state is Unauthenticated) {
+            context.router.root.replaceAll([const LoginRoute()]);
+          }
+        },
+        child: const _HomeView(),
+      ),
+    );
+  }
+}
+
+class _HomeView extends StatelessWidget {
+  const _HomeView();
+
+  @override
+  Widget build(BuildContext context) {
+    final l10n = AppLocalizations.of(context)!;
+
+    return Scaffold(
+      appBar: AppBar(
+        title: Text(l10n.homeTitle),
+      ),
+      drawer: const HomeDrawer(),
+      body: ListView(
+        padding: const EdgeInsets.all(16),
+        children: const [
+          WelcomeCard(),
+          SizedBox(height: 16),
+          QuickActions(),
+        ],
+      ),
+      floatingActionButton: FloatingActionButton(
+        tooltip: l10n.actionCreate,
+        onPressed: () {
+          // TODO: Implement FAB action
+        },
+        child: const Icon(Icons.add),
+      ),
+    );
+  }
+}
@@ -0,0 +1,37 @@
+import 'package:flutter/material.dart';
+import 'package:flutter_bloc/flutter_bloc.dart';
+import 'package:demo_app/state_management/bloc/auth/auth_bloc.dart';
+
+// Submit button of the authentication forms, disabled while a request runs
+class AuthSubmitButton extends StatelessWidget {
+  final String label;
+  final VoidCallback onPressed;
+
+  const AuthSubmitButton({
+    super.key,
+    required this.label,
+    required this.onPressed,
+  });
+
+  @override
+  Widget build(BuildContext context) {
+    return BlocBuilder<AuthBloc, AuthState>(
+      builder: (context, state) {
+        final loading = state is AuthLoading;
+
+        return FilledButton(
+          onPressed: loading ? null : onPressed,
+          child: Padding(
+            padding: const EdgeInsets.symmetric(vertical: 16),
+            child: loading
+                ? const SizedBox.square(
+                    dimension: 20,
+                    child: CircularProgressIndicator(strokeWidth: 2),
+                  )
+                : Text(label),
+          ),
+        );
+      },
+    );
+  }
+}
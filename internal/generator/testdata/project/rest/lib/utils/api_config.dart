@@ -0,0 +1,7 @@
+// Base URL of the REST API, passed with --dart-define=API_BASE_URL=<url>
+class ApiConfig {
+  static const String baseUrl = String.fromEnvironment(
+    'API_BASE_URL',
+    defaultValue: 'https://api.example.com',
+  );
+}
@@ -0,0 +1,8 @@
+part of 'dependency_injector.dart';
+
+final List<RepositoryProvider> _repositories = [
+  // Add your repositories here
+  RepositoryProvider<OnboardingRepository>(
+    create: (context) => OnboardingRepository(),
+  ),
+];
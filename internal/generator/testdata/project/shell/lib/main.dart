@@ -0,0 +1,15 @@
+import 'dart:async';
+
+import 'package:flutter/material.dart';
+import 'package:logger/logger.dart';
+import 'app.dart';
+
+Future<void> main() async {
+  // Binding, backend initialization and runApp share the error zone
+  await runZonedGuarded(() async {
+    WidgetsFlutterBinding.ensureInitialized();
+    runApp(const App());
+  }, (error, stack) {
+    Logger().e('Uncaught error', error: error, stackTrace: stack);
+  });
+}
@@ -0,0 +1,20 @@
+import 'package:auto_route/auto_route.dart';
+import 'package:demo_app/routers/app_router.gr.dart';
+
+@AutoRouterConfig(
+  replaceInRouteName: 'Page,Route',
+)
+class AppRouter extends RootStackRouter {
+  @override
+  List<AutoRoute> get routes => [
+        AutoRoute(page: SplashRoute.page, initial: true),
+        AutoRoute(page: OnboardingRoute.page),
+        AutoRoute(
+          page: AppShellRoute.page,
+          children: [
+            AutoRoute(page: HomeRoute.page, initial: true),
+            AutoRoute(page: ProfileRoute.page),
+          ],
+        ),
+      ];
+}
@@ -0,0 +1,22 @@
+import 'package:equatable/equatable.dart';
+import 'package:flutter_bloc/flutter_bloc.dart';
+import 'package:demo_app/repositories/onboarding_repository.dart';
+
+part 'onboarding_state.dart';
+
+// Current slide of the onboarding carousel and its completion
+class OnboardingCubit extends Cubit<OnboardingState> {
+  final OnboardingRepository _repository;
+
+  OnboardingCubit({required OnboardingRepository repository})
+      : _repository = repository,
+        super(const OnboardingState());
+
+  void pageChanged(int page) => emit(state.copyWith(page: page));
+
+  // Skipping the onboarding completes it too
+  Future<void> complete() async {
+    await _repository.complete();
+    emit(state.copyWith(completed: true));
+  }
+}
@@ -0,0 +1,18 @@
+part of 'onboarding_cubit.dart';
+
+class OnboardingState extends Equatable {
+  final int page;
+  final bool completed;
+
+  const OnboardingState({this.page = 0, this.completed = false});
+
+  OnboardingState copyWith({int? page, bool? completed}) {
+    return OnboardingState(
+      page: page ?? this.page,
+      completed: completed ?? this.completed,
+    );
+  }
+
+  @override
+  List<Object> get props => [page, completed];
+}
@@ -0,0 +1,27 @@
+import 'package:auto_route/auto_route.dart';
+import 'package:flutter/material.dart';
+import 'package:demo_app/routers/app_router.gr.dart';
+import 'package:demo_app/ui/app_shell/widgets/app_navigation_bar.dart';
+
+// Bottom navigation between the main screens, nested routes of the shell
+// that keep their state while switching tabs
+@RoutePage()
+class AppShellPage extends StatelessWidget {
+  const AppShellPage({super.key});
+
+  @override
+  Widget build(BuildContext context) {
+    return AutoTabsRouter(
+      routes: const [
+        HomeRoute(),
+        ProfileRoute(),
+      ],
+      transitionBuilder: (context, child, animation) =>
+          FadeTransition(opacity: animation, child: child),
+      builder: (context, child) => Scaffold(
+        body: child,
+        bottomNavigationBar: const AppNavigationBar(),
+      ),
+    );
+  }
+}
@@ -0,0 +1,31 @@
+import 'package:auto_route/auto_route.dart';
+import 'package:flutter/material.dart';
+import 'package:demo_app/l10n/app_localizations.dart';
+
+// Tabs of the app shell, in the order of its routes
+class AppNavigationBar extends StatelessWidget {
+  const AppNavigationBar({super.key});
+
+  @override
+  Widget build(BuildContext context) {
+    final l10n = AppLocalizations.of(context)!;
+    final tabsRouter = AutoTabsRouter.of(context, watch: true);
+
+    return NavigationBar(
+      selectedIndex: tabsRouter.activeIndex,
+      onDestinationSelected: tabsRouter.setActiveIndex,
+      destinations: [
+        NavigationDestination(
+          icon: const Icon(Icons.home_outlined),
+          selectedIcon: const Icon(Icons.home),
+          label: l10n.homeTitle,
+        ),
+        NavigationDestination(
+          icon: const Icon(Icons.person_outline),
+          selectedIcon: const Icon(Icons.person),
+          label: l10n.profileTitle,
+        ),
+      ],
+    );
+  }
+}
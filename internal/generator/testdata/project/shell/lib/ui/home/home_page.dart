@@ -0,0 +1,55 @@
+import 'package:auto_route/auto_route.dart';
+import 'package:flutter/material.dart';
+import 'package:demo_app/l10n/app_localizations.dart';
+import 'package:demo_app/routers/app_router.gr.dart';
+import 'package:demo_app/ui/home/widgets/home_drawer.dart';
+import 'package:demo_app/ui/home/widgets/quick_actions.dart';
+import 'package:demo_app/ui/home/widgets/welcome_card.dart';
+
+@RoutePage()
+class HomePage extends StatelessWidget {
+  const HomePage({super.key});
+
+  @override
+  Widget build(BuildContext context) {
+    return const _HomeView();
+  }
+}
+
+class _HomeView extends StatelessWidget {
+  const _HomeView();
+
+  @override
+  Widget build(BuildContext context) {
+    final l10n = AppLocalizations.of(context)!;
+
+    return Scaffold(
+      appBar: AppBar(
+        title: Text(l10n.homeTitle),
+        actions: [
+          IconButton(
+            icon: const Icon(Icons.person_outline),
+            tooltip: l10n.profileTitle,
+            onPressed: () => context.navigateTo(const ProfileRoute()),
+          ),
+        ],
+      ),
+      drawer: const HomeDrawer(),
+      body: ListView(
+        padding: const EdgeInsets.all(16),
+        children: const [
+          WelcomeCard(),
+          SizedBox(height: 16),
+          QuickActions(),
+        ],
+      ),
+      floatingActionButton: FloatingActionButton(
+        tooltip: l10n.actionCreate,
+        onPressed: () {
+          // TODO: Implement FAB action
+        },
+        child: const Icon(Icons.add),
+      ),
+    );
+  }
+}
@@ -0,0 +1,71 @@
+import 'package:auto_route/auto_route.dart';
+import 'package:flutter/material.dart';
+import 'package:demo_app/l10n/app_localizations.dart';
+import 'package:demo_app/routers/app_router.gr.dart';
+
+// Navigation drawer of the home screen
+class HomeDrawer extends StatelessWidget {
+  const HomeDrawer({super.key});
+
+  @override
+  Widget build(BuildContext context) {
+    final l10n = AppLocalizations.of(context)!;
+
+    return Drawer(
+      child: ListView(
+        padding: EdgeInsets.zero,
+        children: [
+          const _DrawerHeader(),
+          ListTile(
+            leading: const Icon(Icons.home),
+            title: Text(l10n.homeTitle),
+            onTap: () => Scaffold.of(context).closeDrawer(),
+          ),
+          ListTile(
+            leading: const Icon(Icons.person),
+            title: Text(l10n.profileTitle),
+            onTap: () {
+              Scaffold.of(context).closeDrawer();
+              context.navigateTo(const ProfileRoute());
+            },
+          ),
+        ],
+      ),
+    );
+  }
+}
+
+class _DrawerHeader extends StatelessWidget {
+  const _DrawerHeader();
+
+  @override
+  Widget build(BuildContext context) {
+    final theme = Theme.of(context);
+
+    return DrawerHeader(
+      decoration: BoxDecoration(color: theme.colorScheme.primary),
+      child: Column(
+        crossAxisAlignment: CrossAxisAlignment.start,
+        mainAxisAlignment: MainAxisAlignment.end,
+        children: [
+          CircleAvatar(
+            radius: 32,
+            backgroundColor: theme.colorScheme.onPrimary,
+            child: Icon(
+              Icons.person,
+              size: 32,
+              color: theme.colorScheme.primary,
+            ),
+          ),
+          const SizedBox(height: 8),
+          Text(
+            AppLocalizations.of(context)!.appTitle,
+            style: theme.textTheme.titleLarge?.copyWith(
+              color: theme.colorScheme.onPrimary,
+            ),
+          ),
+        ],
+      ),
+    );
+  }
+}
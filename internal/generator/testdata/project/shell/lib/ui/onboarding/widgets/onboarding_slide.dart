@@ -0,0 +1,44 @@
+import 'package:flutter/material.dart';
+
+// Icon, title and text of an onboarding slide
+class OnboardingSlide extends StatelessWidget {
+  final IconData icon;
+  final String title;
+  final String body;
+
+  const OnboardingSlide({
+    super.key,
+    required this.icon,
+    required this.title,
+    required this.body,
+  });
+
+  @override
+  Widget build(BuildContext context) {
+    final theme = Theme.of(context);
+
+    return Padding(
+      padding: const EdgeInsets.all(32),
+      child: Column(
+        mainAxisAlignment: MainAxisAlignment.center,
+        children: [
+          Icon(icon, size: 120, color: theme.colorScheme.primary),
+          const SizedBox(height: 32),
+          Text(
+            title,
+            style: theme.textTheme.headlineMedium,
+            textAlign: TextAlign.center,
+          ),
+          const SizedBox(height: 16),
+          Text(
+            body,
+            style: theme.textTheme.bodyLarge?.copyWith(
+              color: theme.colorScheme.onSurfaceVariant,
+            ),
+            textAlign: TextAlign.center,
+          ),
+        ],
+      ),
+    );
+  }
+}
@@ -0,0 +1,33 @@
+import 'package:flutter/material.dart';
+
+// Dots marking the current page of a carousel
+class PageIndicator extends StatelessWidget {
+  final int count;
+  final int current;
+
+  const PageIndicator({super.key, required this.count, required this.current});
+
+  @override
+  Widget build(BuildContext context) {
+    final colorScheme = Theme.of(context).colorScheme;
+
+    return Row(
+      mainAxisSize: MainAxisSize.min,
+      spacing: 8,
+      children: [
+        for (var i = 0; i < count; i++)
+          AnimatedContainer(
+            duration: const Duration(milliseconds: 200),
+            width: i == current ? 24 : 8,
+            height: 8,
+            decoration: BoxDecoration(
+              color: i == current
+                  ? colorScheme.primary
+                  : colorScheme.outlineVariant,
+              borderRadius: BorderRadius.circular(4),
+            ),
+          ),
+      ],
+    );
+  }
+}
@@ -0,0 +1,67 @@
+import 'package:auto_route/auto_route.dart';
+import 'package:flutter/material.dart';
+import 'package:flutter_bloc/flutter_bloc.dart';
+import 'package:demo_app/l10n/app_localizations.dart';
+import 'package:demo_app/state_management/cubit/profile/profile_cubit.dart';
+import 'package:demo_app/ui/profile/widgets/account_actions.dart';
+import 'package:demo_app/ui/profile/widgets/edit_profile_field_dialog.dart';
+import 'package:demo_app/ui/profile/widgets/profile_details.dart';
+import 'package:demo_app/ui/profile/widgets/profile_header.dart';
+
+@RoutePage()
+class ProfilePage extends StatelessWidget {
+  const ProfilePage({super.key});
+
+  @override
+  Widget build(BuildContext context) {
+    return BlocProvider(
+      create: (context) => ProfileCubit(),
+      child: const _ProfileView(),
+    );
+  }
+}
+
+class _ProfileView extends StatelessWidget {
+  const _ProfileView();
+
+  Future<void> _editName(BuildContext context) async {
+    final cubit = context.read<ProfileCubit>();
+    final name = await showDialog<String>(
+      context: context,
+      builder: (context) => EditProfileFieldDialog(
+        title: AppLocalizations.of(context)!.profileName,
+        initialValue: cubit.state.name,
+      ),
+    );
+    if (name != null) {
+      cubit.updateName(name);
+    }
+  }
+
+  @override
+  Widget build(BuildContext context) {
+    final l10n = AppLocalizations.of(context)!;
+
+    return Scaffold(
+      appBar: AppBar(
+        title: Text(l10n.profileTitle),
+        actions: [
+          IconButton(
+            icon: const Icon(Icons.edit),
+            tooltip: l10n.profileEditName,
+            onPressed: () => _editName(context),
+          ),
+        ],
+      ),
+      body: ListView(
+        children: const [
+          ProfileHeader(),
+          Divider(),
+          ProfileDetails(),
+          Divider(),
+          AccountActions(),
+        ],
+      ),
+    );
+  }
+}
@@ -0,0 +1,7 @@
+android {
+    namespace = "com.example.demo_app"
+
+    defaultConfig {
+        applicationId = "com.example.demo_app"
+    }
+}
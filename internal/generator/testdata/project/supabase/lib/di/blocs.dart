@@ -0,0 +1,5 @@
+part of 'dependency_injector.dart';
+
+final List<BlocProvider> _blocs = [
+  // Add your BLoCs here
+];
@@ -0,0 +1,5 @@
+part of 'dependency_injector.dart';
+
+final List<SingleChildWidget> _mappers = [
+  // Add your mappers here
+];
@@ -0,0 +1,58 @@
+part of 'dependency_injector.dart';
+
+final List<SingleChildWidget> _providers = [
+  Provider<Logger>(create: (_) => Logger()),
+
+  Provider<PrettyDioLogger>(
+      create: (_) => PrettyDioLogger(
+          requestBody: true, compact: true, requestHeader: true)),
+
+  Provider<Dio>(
+      create: (context) => Dio()
+        ..interceptors
+            .addAll([if (kDebugMode) context.read<PrettyDioLogger>()])),
+
+  Provider<FlutterSecureStorage>(
+    create: (_) => const FlutterSecureStorage(),
+  ),
+
+  Provider<SupabaseClient>(create: (_) => Supabase.instance.client),
+
+  Provider<SupabaseAuthService>(
+    create: (context) => SupabaseAuthService(
+      client: context.read<SupabaseClient>(),
+      logger: context.read<Logger>(),
+    ),
+  ),
+
+  Provider<SupabaseDatabaseService>(
+    create: (context) => SupabaseDatabaseService(
+      client: context.read<SupabaseClient>(),
+      logger: context.read<Logger>(),
+    ),
+  ),
+
+  Provider<SupabaseStorageService>(
+    create: (context) => SupabaseStorageService(
+      client: context.read<SupabaseClient>(),
+      logger: context.read<Logger>(),
+    ),
+  ),
+
+  Provider<NotificationService>(
+    create: (context) => OneSignalNotificationService(
+      appId: const String.fromEnvironment('ONESIGNAL_APP_ID'),
+      logger: context.read<Logger>(),
+    ),
+  ),
+
+  Provider<NotificationRouter>(
+    lazy: false,
+    create: (context) => NotificationRouter(
+      service: context.read<NotificationService>(),
+      router: router,
+      logger: context.read<Logger>(),
+    )..start(),
+    dispose: (_, notificationRouter) => notificationRouter.dispose(),
+  ),
+];
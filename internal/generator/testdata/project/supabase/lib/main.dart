@@ -0,0 +1,17 @@
+import 'dart:async';
+
+import 'package:demo_app/utils/supabase_client.dart';
+import 'package:flutter/material.dart';
+import 'package:logger/logger.dart';
+import 'app.dart';
+
+Future<void> main() async {
+  // Binding, backend initialization and runApp share the error zone
+  await runZonedGuarded(() async {
+    WidgetsFlutterBinding.ensureInitialized();
+    await SupabaseConfig.initialize();
+    runApp(const App());
+  }, (error, stack) {
+    Logger().e('Uncaught error', error: error, stackTrace: stack);
+  });
+}
@@ -0,0 +1,41 @@
+// A notification received by the app or tapped by the user. Put a route in
+// the data payload (e.g. {"route": "/profile"}) to open that page on tap.
+class NotificationMessage {
+  final String? title;
+  final String? body;
+  final Map<String, dynamic> data;
+
+  const NotificationMessage({
+    this.title,
+    this.body,
+    this.data = const {},
+  });
+
+  // Path of the page to open when the notification is tapped
+  String? get route {
+    final route = data['route'];
+    return route is String && route.isNotEmpty ? route : null;
+  }
+}
+
+// Notification provider of the app. NotificationRouter initializes it and
+// asks for the permission at startup.
+abstract class NotificationService {
+  // Notifications received while the app is in the foreground
+  Stream<NotificationMessage> get onMessage;
+
+  // Notifications tapped by the user, including the one that launched the
+  // app, which initialize emits
+  Stream<NotificationMessage> get onTap;
+
+  // Set up the provider and its handlers
+  Future<void> initialize();
+
+  // Ask the user for permission to show notifications
+  Future<bool> requestPermission();
+
+  // Push address of this device for your backend, null without push
+  Future<String?> getToken();
+
+  Future<void> dispose();
+}
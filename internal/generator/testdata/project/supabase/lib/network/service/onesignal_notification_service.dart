@@ -0,0 +1,88 @@
+import 'dart:async';
+
+import 'package:logger/logger.dart';
+import 'package:onesignal_flutter/onesignal_flutter.dart';
+import 'package:demo_app/network/service/notification_service.dart';
+
+// Push notifications through OneSignal. The app ID is passed with
+// --dart-define=ONESIGNAL_APP_ID=<app id>.
+class OneSignalNotificationService implements NotificationService {
+  final String _appId;
+  final Logger _logger;
+  final _messages = StreamController<NotificationMessage>.broadcast();
+  final _taps = StreamController<NotificationMessage>.broadcast();
+
+  OneSignalNotificationService({
+    required String appId,
+    required Logger logger,
+  })  : _appId = appId,
+        _logger = logger;
+
+  @override
+  Stream<NotificationMessage> get onMessage => _messages.stream;
+
+  @override
+  Stream<NotificationMessage> get onTap => _taps.stream;
+
+  @override
+  Future<void> initialize() async {
+    if (_appId.isEmpty) {
+      _logger.w('ONESIGNAL_APP_ID is not set, notifications are disabled');
+      return;
+    }
+
+    try {
+      OneSignal.initialize(_appId);
+      // OneSignal shows foreground notifications unless the event is
+      // prevented
+      OneSignal.Notifications.addForegroundWillDisplayListener(_onForeground);
+      OneSignal.Notifications.addClickListener(_onClick);
+    } catch (e) {
+      _logger.e('Notification initialization error', error: e);
+      rethrow;
+    }
+  }
+
+  @override
+  Future<bool> requestPermission() async {
+    if (_appId.isEmpty) {
+      return false;
+    }
+
+    try {
+      return await OneSignal.Notifications.requestPermission(true);
+    } catch (e) {
+      _logger.e('Notification permission error', error: e);
+      rethrow;
+    }
+  }
+
+  // OneSignal subscription ID of this device, to target it from your backend
+  @override
+  Future<String?> getToken() async =>
+      _appId.isEmpty ? null : OneSignal.User.pushSubscription.id;
+
+  @override
+  Future<void> dispose() async {
+    if (_appId.isNotEmpty) {
+      OneSignal.Notifications.removeForegroundWillDisplayListener(
+          _onForeground);
+      OneSignal.Notifications.removeClickListener(_onClick);
+    }
+    await _messages.close();
+    await _taps.close();
+  }
+
+  void _onForeground(OSNotificationWillDisplayEvent event) =>
+      _messages.add(_toMessage(event.notification));
+
+  void _onClick(OSNotificationClickEvent event) =>
+      _taps.add(_toMessage(event.notification));
+
+  NotificationMessage _toMessage(OSNotification notification) =>
+      NotificationMessage(
+        title: notification.title,
+        body: notification.body,
+        data: notification.additionalData ?? const {},
+      );
+}
@@ -0,0 +1,98 @@
+import 'package:logger/logger.dart';
+import 'package:supabase_flutter/supabase_flutter.dart';
+
+class SupabaseAuthService {
+  final SupabaseClient _client;
+  final Logger _logger;
+
+  SupabaseAuthService({
+    required SupabaseClient client,
+    required Logger logger,
+  })  : _client = client,
+        _logger = logger;
+
+  // Get current user
+  User? get currentUser => _client.auth.currentUser;
+
+  // Session restored by Supabase.initialize, null when signed out
+  Session? get currentSession => _client.auth.currentSession;
+
+  // Auth state changes
+  Stream<AuthState> get authStateChanges => _client.auth.onAuthStateChange;
+
+  // Sign in with email and password
+  Future<AuthResponse> signInWithEmailAndPassword({
+    required String email,
+    required String password,
+  }) async {
+    try {
+      return await _client.auth.signInWithPassword(
+        email: email,
+        password: password,
+      );
+    } catch (e) {
+      _logger.e('Sign in error', error: e);
+      rethrow;
+    }
+  }
+
+  // Register with email and password
+  Future<AuthResponse> signUp({
+    required String email,
+    required String password,
+  }) async {
+    try {
+      return await _client.auth.signUp(
+        email: email,
+        password: password,
+      );
+    } catch (e) {
+      _logger.e('Sign up error', error: e);
+      rethrow;
+    }
+  }
+
+  // Open the OAuth page of the provider. The session comes back with the
+  // redirect to the app, through authStateChanges.
+  Future<bool> signInWithOAuth(OAuthProvider provider) async {
+    try {
+      return await _client.auth.signInWithOAuth(
+        provider,
+        redirectTo: 'com.example.demoapp://login-callback',
+      );
+    } catch (e) {
+      _logger.e('OAuth sign in error', error: e);
+      rethrow;
+    }
+  }
+
+  // Sign out
+  Future<void> signOut() async {
+    try {
+      await _client.auth.signOut();
+    } catch (e) {
+      _logger.e('Sign out error', error: e);
+      rethrow;
+    }
+  }
+
+  // Refresh an expired session
+  Future<AuthResponse> refreshSession() async {
+    try {
+      return await _client.auth.refreshSession();
+    } catch (e) {
+      _logger.e('Session refresh error', error: e);
+      rethrow;
+    }
+  }
+
+  // Reset password
+  Future<void> resetPassword(String email) async {
+    try {
+      await _client.auth.resetPasswordForEmail(email);
+    } catch (e) {
+      _logger.e('Password reset error', error: e);
+      rethrow;
+    }
+  }
+}
@@ -0,0 +1,79 @@
+import 'package:logger/logger.dart';
+import 'package:supabase_flutter/supabase_flutter.dart';
+
+class SupabaseDatabaseService {
+  final SupabaseClient _client;
+  final Logger _logger;
+
+  SupabaseDatabaseService({
+    required SupabaseClient client,
+    required Logger logger,
+  })  : _client = client,
+        _logger = logger;
+
+  // Get every row of a table
+  Future<List<Map<String, dynamic>>> select(String table) async {
+    try {
+      return await _client.from(table).select();
+    } catch (e) {
+      _logger.e('Select error', error: e);
+      rethrow;
+    }
+  }
+
+  // Get a row by primary key
+  Future<Map<String, dynamic>?> selectById(String table, Object id) async {
+    try {
+      return await _client.from(table).select().eq('id', id).maybeSingle();
+    } catch (e) {
+      _logger.e('Select error', error: e);
+      rethrow;
+    }
+  }
+
+  // Insert a row and return it
+  Future<Map<String, dynamic>> insert(
+    String table,
+    Map<String, dynamic> values,
+  ) async {
+    try {
+      return await _client.from(table).insert(values).select().single();
+    } catch (e) {
+      _logger.e('Insert error', error: e);
+      rethrow;
+    }
+  }
+
+  // Update a row by primary key and return it
+  Future<Map<String, dynamic>> update(
+    String table,
+    Object id,
+    Map<String, dynamic> values,
+  ) async {
+    try {
+      return await _client
+          .from(table)
+          .update(values)
+          .eq('id', id)
+          .select()
+          .single();
+    } catch (e) {
+      _logger.e('Update error', error: e);
+      rethrow;
+    }
+  }
+
+  // Delete a row by primary key
+  Future<void> delete(String table, Object id) async {
+    try {
+      await _client.from(table).delete().eq('id', id);
+    } catch (e) {
+      _logger.e('Delete error', error: e);
+      rethrow;
+    }
+  }
+
+  // Watch the rows of a table in realtime
+  Stream<List<Map<String, dynamic>>> watch(String table) =>
+      _client.from(table).stream(primaryKey: ['id']);
+}
@@ -0,0 +1,68 @@
+import 'dart:io';
+import 'dart:typed_data';
+
+import 'package:logger/logger.dart';
+import 'package:supabase_flutter/supabase_flutter.dart';
+
+class SupabaseStorageService {
+  final SupabaseClient _client;
+  final Logger _logger;
+
+  SupabaseStorageService({
+    required SupabaseClient client,
+    required Logger logger,
+  })  : _client = client,
+        _logger = logger;
+
+  // Upload a file to a bucket and return its public URL
+  Future<String> uploadFile(String bucket, String path, File file) async {
+    try {
+      await _client.storage
+          .from(bucket)
+          .upload(path, file, fileOptions: const FileOptions(upsert: true));
+      return _client.storage.from(bucket).getPublicUrl(path);
+    } catch (e) {
+      _logger.e('Upload error', error: e);
+      rethrow;
+    }
+  }
+
+  // Upload raw bytes (e.g. a picked image on web) and return the public URL
+  Future<String> uploadData(String bucket, String path, Uint8List data) async {
+    try {
+      await _client.storage
+          .from(bucket)
+          .uploadBinary(path, data, fileOptions: const FileOptions(upsert: true));
+      return _client.storage.from(bucket).getPublicUrl(path);
+    } catch (e) {
+      _logger.e('Upload error', error: e);
+      rethrow;
+    }
+  }
+
+  // Get a temporary URL for a file in a private bucket
+  Future<String> createSignedUrl(
+    String bucket,
+    String path, {
+    Duration expiresIn = const Duration(hours: 1),
+  }) async {
+    try {
+      return await _client.storage
+          .from(bucket)
+          .createSignedUrl(path, expiresIn.inSeconds);
+    } catch (e) {
+      _logger.e('Signed URL error', error: e);
+      rethrow;
+    }
+  }
+
+  // Delete a file from a bucket
+  Future<void> delete(String bucket, String path) async {
+    try {
+      await _client.storage.from(bucket).remove([path]);
+    } catch (e) {
+      _logger.e('Delete error', error: e);
+      rethrow;
+    }
+  }
+}
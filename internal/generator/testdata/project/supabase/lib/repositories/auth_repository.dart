@@ -0,0 +1,126 @@
+import 'package:logger/logger.dart';
+import 'package:demo_app/network/service/supabase_auth_service.dart';
+import 'package:supabase_flutter/supabase_flutter.dart';
+
+// Reasons an authentication request fails, shown to the user
+enum AuthErrorCode {
+  invalidCredentials,
+  emailAlreadyInUse,
+  weakPassword,
+  invalidEmail,
+  tooManyRequests,
+  network,
+  cancelled,
+  unknown,
+}
+
+enum SocialProvider {
+  github,
+}
+
+class AuthFailure implements Exception {
+  final AuthErrorCode code;
+
+  const AuthFailure(this.code);
+
+  @override
+  String toString() => 'AuthFailure($code)';
+}
+
+class AuthRepository {
+  final SupabaseAuthService _service;
+  final Logger _logger;
+
+  AuthRepository({
+    required SupabaseAuthService service,
+    required Logger logger,
+  })  : _service = service,
+        _logger = logger;
+
+  bool get isSignedIn => _service.currentUser != null;
+
+  // Emits whether a user is signed in, on every change
+  Stream<bool> get signedInChanges =>
+      _service.authStateChanges.map((state) => state.session != null);
+
+  // Supabase.initialize restores the persisted session, an expired one is
+  // refreshed
+  Future<bool> restoreSession() => _guard('Session restore', () async {
+        final session = _service.currentSession;
+        if (session == null) {
+          return false;
+        }
+        if (session.isExpired) {
+          await _service.refreshSession();
+        }
+        return _service.currentSession != null;
+      });
+
+  Future<void> signIn({
+    required String email,
+    required String password,
+  }) =>
+      _guard('Sign in', () async {
+        await _service.signInWithEmailAndPassword(
+          email: email,
+          password: password,
+        );
+      });
+
+  // Returns false: the sign in goes on in the OAuth page and the session
+  // comes back through signedInChanges
+  Future<bool> signInWith(SocialProvider provider) =>
+      _guard('${provider.name} sign in', () async {
+        await _service.signInWithOAuth(switch (provider) {
+          SocialProvider.github => OAuthProvider.github,
+        });
+        return false;
+      });
+
+  // Returns whether the new user is signed in, false when the email must
+  // be confirmed first
+  Future<bool> signUp({
+    required String email,
+    required String password,
+  }) =>
+      _guard('Sign up', () async {
+        final response = await _service.signUp(
+          email: email,
+          password: password,
+        );
+        return response.session != null;
+      });
+
+  Future<void> resetPassword(String email) =>
+      _guard('Password reset', () => _service.resetPassword(email));
+
+  Future<void> signOut() => _guard('Sign out', _service.signOut);
+
+  Future<T> _guard<T>(String action, Future<T> Function() request) async {
+    try {
+      return await request();
+    } on AuthRetryableFetchException catch (e) {
+      _logger.e('$action error', error: e);
+      throw const AuthFailure(AuthErrorCode.network);
+    } on AuthException catch (e) {
+      _logger.e('$action error', error: e);
+      throw AuthFailure(_code(e.code));
+    } catch (e) {
+      _logger.e('$action error', error: e);
+      throw const AuthFailure(AuthErrorCode.unknown);
+    }
+  }
+
+  AuthErrorCode _code(String? code) => switch (code) {
+        'invalid_credentials' => AuthErrorCode.invalidCredentials,
+        'user_already_exists' ||
+        'email_exists' =>
+          AuthErrorCode.emailAlreadyInUse,
+        'weak_password' => AuthErrorCode.weakPassword,
+        'email_address_invalid' => AuthErrorCode.invalidEmail,
+        'over_request_rate_limit' ||
+        'over_email_send_rate_limit' =>
+          AuthErrorCode.tooManyRequests,
+        _ => AuthErrorCode.unknown,
+      };
+}
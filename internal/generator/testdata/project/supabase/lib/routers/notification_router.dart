@@ -0,0 +1,57 @@
+import 'dart:async';
+
+import 'package:auto_route/auto_route.dart';
+import 'package:flutter/widgets.dart';
+import 'package:logger/logger.dart';
+import 'package:demo_app/network/service/notification_service.dart';
+
+// Opens the page named by the route of tapped notifications. The DI starts
+// it with the app, which also asks for the notification permission.
+class NotificationRouter {
+  final NotificationService _service;
+  final StackRouter _router;
+  final Logger _logger;
+  StreamSubscription<NotificationMessage>? _subscription;
+
+  NotificationRouter({
+    required NotificationService service,
+    required StackRouter router,
+    required Logger logger,
+  })  : _service = service,
+        _router = router,
+        _logger = logger;
+
+  Future<void> start() async {
+    // Listen first: initialize emits the notification that launched the app
+    _subscription = _service.onTap.listen(_open);
+
+    try {
+      await _service.initialize();
+      final granted = await _service.requestPermission();
+      _logger.i('Notification permission ${granted ? 'granted' : 'denied'}');
+    } catch (e) {
+      _logger.e('Notification setup error', error: e);
+    }
+  }
+
+  Future<void> dispose() async {
+    await _subscription?.cancel();
+    await _service.dispose();
+  }
+
+  void _open(NotificationMessage message) {
+    final route = message.route;
+    if (route == null) {
+      return;
+    }
+
+    // After a cold start, the router is mounted with the first frame
+    WidgetsBinding.instance
+      ..addPostFrameCallback((_) => _router.pushPath(
+            route,
+            onFailure: (failure) => _logger
+                .w('No page for notification route $route', error: failure),
+          ))
+      ..ensureVisualUpdate();
+  }
+}
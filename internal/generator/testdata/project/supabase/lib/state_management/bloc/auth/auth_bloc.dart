@@ -0,0 +1,84 @@
+import 'package:equatable/equatable.dart';
+import 'package:flutter_bloc/flutter_bloc.dart';
+import 'package:demo_app/repositories/auth_repository.dart';
+
+part 'auth_event.dart';
+part 'auth_state.dart';
+
+class AuthBloc extends Bloc<AuthEvent, AuthState> {
+  final AuthRepository _repository;
+
+  AuthBloc({required AuthRepository repository})
+      : _repository = repository,
+        super(AuthInitial()) {
+    on<SignIn>(_onSignIn);
+    on<SignInWith>(_onSignInWith);
+    on<SignUp>(_onSignUp);
+    on<ResetPassword>(_onResetPassword);
+    on<SignOut>(_onSignOut);
+  }
+
+  Future<void> _onSignIn(SignIn event, Emitter<AuthState> emit) async {
+    emit(AuthLoading());
+    try {
+      await _repository.signIn(email: event.email, password: event.password);
+      emit(Authenticated());
+    } on AuthFailure catch (e) {
+      emit(AuthError(e.code));
+    }
+  }
+
+  Future<void> _onSignInWith(SignInWith event, Emitter<AuthState> emit) async {
+    emit(AuthLoading());
+    try {
+      if (await _repository.signInWith(event.provider)) {
+        emit(Authenticated());
+        return;
+      }
+      // The sign in goes on outside of the app, wait for the session
+      emit(Unauthenticated());
+      await emit.onEach<bool>(
+        _repository.signedInChanges.where((signedIn) => signedIn).take(1),
+        onData: (_) => emit(Authenticated()),
+      );
+    } on AuthFailure catch (e) {
+      emit(AuthError(e.code));
+    }
+  }
+
+  Future<void> _onSignUp(SignUp event, Emitter<AuthState> emit) async {
+    emit(AuthLoading());
+    try {
+      final signedIn = await _repository.signUp(
+        email: event.email,
+        password: event.password,
+      );
+      emit(signedIn ? Authenticated() : SignUpConfirmationSent());
+    } on AuthFailure catch (e) {
+      emit(AuthError(e.code));
+    }
+  }
+
+  Future<void> _onResetPassword(
+    ResetPassword event,
+    Emitter<AuthState> emit,
+  ) async {
+    emit(AuthLoading());
+    try {
+      await _repository.resetPassword(event.email);
+      emit(PasswordResetSent());
+    } on AuthFailure catch (e) {
+      emit(AuthError(e.code));
+    }
+  }
+
+  Future<void> _onSignOut(SignOut event, Emitter<AuthState> emit) async {
+    emit(AuthLoading());
+    try {
+      await _repository.signOut();
+      emit(Unauthenticated());
+    } on AuthFailure catch (e) {
+      emit(AuthError(e.code));
+    }
+  }
+}
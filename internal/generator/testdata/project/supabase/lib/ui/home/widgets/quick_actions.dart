@@ -0,0 +1,61 @@
+import 'package:flutter/material.dart';
+import 'package:demo_app/l10n/app_localizations.dart';
+import 'package:demo_app/ui/home/widgets/action_card.dart';
+
+// Grid of shortcuts on the home screen
+class QuickActions extends StatelessWidget {
+  const QuickActions({super.key});
+
+  @override
+  Widget build(BuildContext context) {
+    final l10n = AppLocalizations.of(context)!;
+
+    return Column(
+      crossAxisAlignment: CrossAxisAlignment.stretch,
+      children: [
+        Text(
+          l10n.quickActionsTitle,
+          style: Theme.of(context).textTheme.titleLarge,
+        ),
+        const SizedBox(height: 8),
+        GridView.count(
+          shrinkWrap: true,
+          physics: const NeverScrollableScrollPhysics(),
+          crossAxisCount: 2,
+          mainAxisSpacing: 16,
+          crossAxisSpacing: 16,
+          children: [
+            ActionCard(
+              icon: Icons.add_circle_outline,
+              title: l10n.actionCreate,
+              onTap: () {
+                // TODO: Implement action
+              },
+            ),
+            ActionCard(
+              icon: Icons.list_alt,
+              title: l10n.actionViewAll,
+              onTap: () {
+                // TODO: Implement action
+              },
+            ),
+            ActionCard(
+              icon: Icons.search,
+              title: l10n.actionSearch,
+              onTap: () {
+                // TODO: Implement action
+              },
+            ),
+            ActionCard(
+              icon: Icons.favorite_outline,
+              title: l10n.actionFavorites,
+              onTap: () {
+                // TODO: Implement action
+              },
+            ),
+          ],
+        ),
+      ],
+    );
+  }
+}
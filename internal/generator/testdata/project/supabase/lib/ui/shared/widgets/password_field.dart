@@ -0,0 +1,39 @@
+import 'package:flutter/material.dart';
+import 'package:demo_app/l10n/app_localizations.dart';
+import 'package:demo_app/utils/validators.dart';
+
+class PasswordField extends StatelessWidget {
+  final TextEditingController controller;
+  final String? label;
+  final FormFieldValidator<String>? validator;
+  final TextInputAction textInputAction;
+  final ValueChanged<String>? onSubmitted;
+
+  const PasswordField({
+    super.key,
+    required this.controller,
+    this.label,
+    this.validator,
+    this.textInputAction = TextInputAction.done,
+    this.onSubmitted,
+  });
+
+  @override
+  Widget build(BuildContext context) {
+    final l10n = AppLocalizations.of(context)!;
+
+    return TextFormField(
+      controller: controller,
+      obscureText: true,
+      autofillHints: const [AutofillHints.password],
+      textInputAction: textInputAction,
+      onFieldSubmitted: onSubmitted,
+      decoration: InputDecoration(
+        labelText: label ?? l10n.passwordLabel,
+        prefixIcon: const Icon(Icons.lock_outlined),
+        border: const OutlineInputBorder(),
+      ),
+      validator: validator ?? (value) => Validators.password(l10n, value),
+    );
+  }
+}
@@ -0,0 +1,95 @@
+import 'package:auto_route/auto_route.dart';
+import 'package:flutter/material.dart';
+import 'package:flutter_bloc/flutter_bloc.dart';
+import 'package:demo_app/l10n/app_localizations.dart';
+import 'package:demo_app/state_management/bloc/auth/auth_bloc.dart';
+import 'package:demo_app/ui/shared/widgets/auth_header.dart';
+import 'package:demo_app/ui/shared/widgets/auth_submit_button.dart';
+import 'package:demo_app/ui/shared/widgets/email_field.dart';
+import 'package:demo_app/ui/shared/widgets/password_field.dart';
+import 'package:demo_app/utils/validators.dart';
+
+// The form keeps its controllers only, the request state is in AuthBloc
+class SignUpForm extends StatefulWidget {
+  const SignUpForm({super.key});
+
+  @override
+  State<SignUpForm> createState() => _SignUpFormState();
+}
+
+class _SignUpFormState extends State<SignUpForm> {
+  final _formKey = GlobalKey<FormState>();
+  final _emailController = TextEditingController();
+  final _passwordController = TextEditingController();
+  final _confirmationController = TextEditingController();
+
+  @override
+  void dispose() {
+    _emailController.dispose();
+    _passwordController.dispose();
+    _confirmationController.dispose();
+    super.dispose();
+  }
+
+  void _submit() {
+    if (!(_formKey.currentState?.validate() ?? false)) {
+      return;
+    }
+    context.read<AuthBloc>().add(SignUp(
+          email: _emailController.text.trim(),
+          password: _passwordController.text,
+        ));
+  }
+
+  @override
+  Widget build(BuildContext context) {
+    final l10n = AppLocalizations.of(context)!;
+
+    return Form(
+      key: _formKey,
+      child: AutofillGroup(
+        child: Column(
+          crossAxisAlignment: CrossAxisAlignment.stretch,
+          children: [
+            AuthHeader(
+              icon: Icons.person_add_outlined,
+              title: l10n.signUpTitle,
+              subtitle: l10n.signUpSubtitle,
+            ),
+            const SizedBox(height: 48),
+            EmailField(controller: _emailController),
+            const SizedBox(height: 16),
+            PasswordField(
+              controller: _passwordController,
+              textInputAction: TextInputAction.next,
+            ),
+            const SizedBox(height: 16),
+            PasswordField(
+              controller: _confirmationController,
+              label: l10n.confirmPasswordLabel,
+              validator: (value) => Validators.passwordConfirmation(
+                l10n,
+                value,
+                _passwordController.text,
+              ),
+              onSubmitted: (_) => _submit(),
+            ),
+            const SizedBox(height: 24),
+            AuthSubmitButton(label: l10n.signUpButton, onPressed: _submit),
+            const SizedBox(height: 24),
+            Row(
+              mainAxisAlignment: MainAxisAlignment.center,
+              children: [
+                Text(l10n.haveAccountPrompt),
+                TextButton(
+                  onPressed: () => context.router.maybePop(),
+                  child: Text(l10n.signInButton),
+                ),
+              ],
+            ),
+          ],
+        ),
+      ),
+    );
+  }
+}
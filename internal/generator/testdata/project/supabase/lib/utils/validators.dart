@@ -0,0 +1,38 @@
+import 'package:demo_app/l10n/app_localizations.dart';
+
+// Validators of the authentication forms, returning localized errors
+class Validators {
+  static const minPasswordLength = 6;
+
+  static final _email = RegExp(r'^[^@\s]+@[^@\s]+\.[^@\s]+$');
+
+  const Validators._();
+
+  static String? email(AppLocalizations l10n, String? value) {
+    final email = value?.trim() ?? '';
+    if (email.isEmpty) {
+      return l10n.emailRequired;
+    }
+    if (!_email.hasMatch(email)) {
+      return l10n.emailInvalid;
+    }
+    return null;
+  }
+
+  static String? password(AppLocalizations l10n, String? value) {
+    if (value == null || value.isEmpty) {
+      return l10n.passwordRequired;
+    }
+    if (value.length < minPasswordLength) {
+      return l10n.passwordTooShort;
+    }
+    return null;
+  }
+
+  static String? passwordConfirmation(
+    AppLocalizations l10n,
+    String? value,
+    String password,
+  ) =>
+      value == password ? null : l10n.passwordsDoNotMatch;
+}
@@ -0,0 +1,20 @@
+import 'package:equatable/equatable.dart';
+import 'package:flutter_bloc/flutter_bloc.dart';
+
+part 'profile_state.dart';
+
+// Details shown on the profile screen
+class ProfileCubit extends Cubit<ProfileState> {
+  // TODO: Load and save the profile through a repository
+  ProfileCubit() : super(const ProfileState());
+
+  void updateName(String name) => emit(state.copyWith(name: name));
+
+  void updatePhone(String phone) => emit(state.copyWith(phone: phone));
+
+  void updateLocation(String location) =>
+      emit(state.copyWith(location: location));
+
+  void updateBirthday(DateTime birthday) =>
+      emit(state.copyWith(birthday: birthday));
+}
@@ -0,0 +1,19 @@
+import 'package:equatable/equatable.dart';
+import 'package:flutter_bloc/flutter_bloc.dart';
+
+part 'settings_state.dart';
+
+// Preferences shown on the settings screen
+class SettingsCubit extends Cubit<SettingsState> {
+  // TODO: Load and save the preferences, e.g. with shared_preferences
+  SettingsCubit() : super(const SettingsState());
+
+  void toggleNotifications(bool enabled) =>
+      emit(state.copyWith(notificationsEnabled: enabled));
+
+  void toggleDarkMode(bool enabled) =>
+      emit(state.copyWith(darkModeEnabled: enabled));
+
+  void toggleAutoSync(bool enabled) =>
+      emit(state.copyWith(autoSyncEnabled: enabled));
+}
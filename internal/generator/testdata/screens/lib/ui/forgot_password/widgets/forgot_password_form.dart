@@ -0,0 +1,62 @@
+import 'package:flutter/material.dart';
+import 'package:flutter_bloc/flutter_bloc.dart';
+import 'package:demo_app/l10n/app_localizations.dart';
+import 'package:demo_app/state_management/bloc/auth/auth_bloc.dart';
+import 'package:demo_app/ui/shared/widgets/auth_header.dart';
+import 'package:demo_app/ui/shared/widgets/auth_submit_button.dart';
+import 'package:demo_app/ui/shared/widgets/email_field.dart';
+
+// The form keeps its controller only, the request state is in AuthBloc
+class ForgotPasswordForm extends StatefulWidget {
+  const ForgotPasswordForm({super.key});
+
+  @override
+  State<ForgotPasswordForm> createState() => _ForgotPasswordFormState();
+}
+
+class _ForgotPasswordFormState extends State<ForgotPasswordForm> {
+  final _formKey = GlobalKey<FormState>();
+  final _emailController = TextEditingController();
+
+  @override
+  void dispose() {
+    _emailController.dispose();
+    super.dispose();
+  }
+
+  void _submit() {
+    if (!(_formKey.currentState?.validate() ?? false)) {
+      return;
+    }
+    context
+        .read<AuthBloc>()
+        .add(ResetPassword(email: _emailController.text.trim()));
+  }
+
+  @override
+  Widget build(BuildContext context) {
+    final l10n = AppLocalizations.of(context)!;
+
+    return Form(
+      key: _formKey,
+      child: Column(
+        crossAxisAlignment: CrossAxisAlignment.stretch,
+        children: [
+          AuthHeader(
+            icon: Icons.lock_reset,
+            title: l10n.forgotPasswordTitle,
+            subtitle: l10n.forgotPasswordSubtitle,
+          ),
+          const SizedBox(height: 48),
+          EmailField(
+            controller: _emailController,
+            textInputAction: TextInputAction.done,
+            onSubmitted: (_) => _submit(),
+          ),
+          const SizedBox(height: 24),
+          AuthSubmitButton(label: l10n.sendResetLinkButton, onPressed: _submit),
+        ],
+      ),
+    );
+  }
+}
@@ -0,0 +1,89 @@
+import 'package:flutter/material.dart';
+import 'package:flutter_bloc/flutter_bloc.dart';
+import 'package:demo_app/l10n/app_localizations.dart';
+import 'package:demo_app/state_management/cubit/profile/profile_cubit.dart';
+import 'package:demo_app/ui/profile/widgets/edit_profile_field_dialog.dart';
+import 'package:demo_app/ui/profile/widgets/profile_detail_tile.dart';
+
+// Editable contact details of the profile
+class ProfileDetails extends StatelessWidget {
+  const ProfileDetails({super.key});
+
+  Future<void> _edit(
+    BuildContext context, {
+    required String title,
+    required String? value,
+    required void Function(String) onSaved,
+  }) async {
+    final edited = await showDialog<String>(
+      context: context,
+      builder: (context) => EditProfileFieldDialog(
+        title: title,
+        initialValue: value,
+      ),
+    );
+    if (edited != null) {
+      onSaved(edited);
+    }
+  }
+
+  Future<void> _editBirthday(BuildContext context, DateTime? birthday) async {
+    final cubit = context.read<ProfileCubit>();
+    final now = DateTime.now();
+    final date = await showDatePicker(
+      context: context,
+      initialDate: birthday ?? DateTime(now.year - 30),
+      firstDate: DateTime(1900),
+      lastDate: now,
+    );
+    if (date != null) {
+      cubit.updateBirthday(date);
+    }
+  }
+
+  @override
+  Widget build(BuildContext context) {
+    final l10n = AppLocalizations.of(context)!;
+    final dates = MaterialLocalizations.of(context);
+
+    return BlocBuilder<ProfileCubit, ProfileState>(
+      builder: (context, state) {
+        final cubit = context.read<ProfileCubit>();
+        final birthday = state.birthday;
+
+        return Column(
+          children: [
+            ProfileDetailTile(
+              icon: Icons.phone,
+              label: l10n.profilePhone,
+              value: state.phone,
+              onTap: () => _edit(
+                context,
+                title: l10n.profilePhone,
+                value: state.phone,
+                onSaved: cubit.updatePhone,
+              ),
+            ),
+            ProfileDetailTile(
+              icon: Icons.location_on,
+              label: l10n.profileLocation,
+              value: state.location,
+              onTap: () => _edit(
+                context,
+                title: l10n.profileLocation,
+                value: state.location,
+                onSaved: cubit.updateLocation,
+              ),
+            ),
+            ProfileDetailTile(
+              icon: Icons.cake,
+              label: l10n.profileBirthday,
+              value: birthday == null ? null : dates.formatMediumDate(birthday),
+              onTap: () => _editBirthday(context, birthday),
+            ),
+          ],
+        );
+      },
+    );
+  }
+}
@@ -0,0 +1,38 @@
+import 'package:auto_route/auto_route.dart';
+import 'package:flutter/material.dart';
+import 'package:flutter_bloc/flutter_bloc.dart';
+import 'package:demo_app/l10n/app_localizations.dart';
+import 'package:demo_app/state_management/cubit/settings/settings_cubit.dart';
+import 'package:demo_app/ui/settings/widgets/about_settings.dart';
+import 'package:demo_app/ui/settings/widgets/app_settings.dart';
+import 'package:demo_app/ui/settings/widgets/general_settings.dart';
+import 'package:demo_app/ui/settings/widgets/section_header.dart';
+
+@RoutePage()
+class SettingsPage extends StatelessWidget {
+  const SettingsPage({super.key});
+
+  @override
+  Widget build(BuildContext context) {
+    final l10n = AppLocalizations.of(context)!;
+
+    return BlocProvider(
+      create: (context) => SettingsCubit(),
+      child: Scaffold(
+        appBar: AppBar(title: Text(l10n.settingsTitle)),
+        body: ListView(
+          children: [
+            SectionHeader(title: l10n.settingsGeneral),
+            const GeneralSettings(),
+            const Divider(),
+            SectionHeader(title: l10n.settingsApp),
+            const AppSettings(),
+            const Divider(),
+            SectionHeader(title: l10n.settingsAbout),
+            const AboutSettings(),
+          ],
+        ),
+      ),
+    );
+  }
+}
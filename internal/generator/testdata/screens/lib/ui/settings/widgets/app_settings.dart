@@ -0,0 +1,38 @@
+import 'package:flutter/material.dart';
+import 'package:demo_app/l10n/app_localizations.dart';
+
+// Language and storage settings
+class AppSettings extends StatelessWidget {
+  const AppSettings({super.key});
+
+  @override
+  Widget build(BuildContext context) {
+    final l10n = AppLocalizations.of(context)!;
+
+    return Column(
+      children: [
+        ListTile(
+          leading: const Icon(Icons.language),
+          title: Text(l10n.settingsLanguage),
+          subtitle: Text(l10n.languageName),
+          trailing: const Icon(Icons.chevron_right),
+          onTap: () {
+            // TODO: Show language picker
+          },
+        ),
+        ListTile(
+          leading: const Icon(Icons.storage),
+          title: Text(l10n.settingsClearCache),
+          subtitle: Text(l10n.settingsClearCacheDescription),
+          trailing: const Icon(Icons.chevron_right),
+          onTap: () {
+            // TODO: Clear cache
+            ScaffoldMessenger.of(context).showSnackBar(
+              SnackBar(content: Text(l10n.cacheCleared)),
+            );
+          },
+        ),
+      ],
+    );
+  }
+}
@@ -0,0 +1,35 @@
+import 'package:flutter/material.dart';
+import 'package:demo_app/l10n/app_localizations.dart';
+import 'package:demo_app/utils/validators.dart';
+
+class EmailField extends StatelessWidget {
+  final TextEditingController controller;
+  final TextInputAction textInputAction;
+  final ValueChanged<String>? onSubmitted;
+
+  const EmailField({
+    super.key,
+    required this.controller,
+    this.textInputAction = TextInputAction.next,
+    this.onSubmitted,
+  });
+
+  @override
+  Widget build(BuildContext context) {
+    final l10n = AppLocalizations.of(context)!;
+
+    return TextFormField(
+      controller: controller,
+      keyboardType: TextInputType.emailAddress,
+      autofillHints: const [AutofillHints.email],
+      textInputAction: textInputAction,
+      onFieldSubmitted: onSubmitted,
+      decoration: InputDecoration(
+        labelText: l10n.emailLabel,
+        prefixIcon: const Icon(Icons.email_outlined),
+        border: const OutlineInputBorder(),
+      ),
+      validator: (value) => Validators.email(l10n, value),
+    );
+  }
+}
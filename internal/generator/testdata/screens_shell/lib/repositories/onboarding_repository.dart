@@ -0,0 +1,16 @@
+import 'package:shared_preferences/shared_preferences.dart';
+
+// Remembers whether the onboarding was completed
+class OnboardingRepository {
+  static const _completedKey = 'onboarding_completed';
+
+  final SharedPreferencesAsync _preferences;
+
+  OnboardingRepository({SharedPreferencesAsync? preferences})
+      : _preferences = preferences ?? SharedPreferencesAsync();
+
+  Future<bool> isCompleted() async =>
+      await _preferences.getBool(_completedKey) ?? false;
+
+  Future<void> complete() => _preferences.setBool(_completedKey, true);
+}
@@ -0,0 +1,33 @@
+import 'package:flutter_bloc/flutter_bloc.dart';
+import 'package:demo_app/repositories/onboarding_repository.dart';
+
+// Screens the app can start on
+enum SplashDestination {
+  onboarding,
+  main,
+}
+
+// Decides the screen the app starts on, null while the splash screen shows
+class SplashCubit extends Cubit<SplashDestination?> {
+  final OnboardingRepository _onboarding;
+
+  // Keeps the splash screen from flashing when starting is instant
+  static const _minimumDuration = Duration(milliseconds: 800);
+
+  SplashCubit({required OnboardingRepository onboarding})
+      : _onboarding = onboarding,
+        super(null);
+
+  Future<void> start() async {
+    final destination = _destination();
+    await Future<void>.delayed(_minimumDuration);
+    emit(await destination);
+  }
+
+  Future<SplashDestination> _destination() async {
+    if (!await _onboarding.isCompleted()) {
+      return SplashDestination.onboarding;
+    }
+    return SplashDestination.main;
+  }
+}
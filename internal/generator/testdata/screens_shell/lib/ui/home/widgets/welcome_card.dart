@@ -0,0 +1,27 @@
+import 'package:flutter/material.dart';
+import 'package:demo_app/l10n/app_localizations.dart';
+
+// Greeting on top of the home screen
+class WelcomeCard extends StatelessWidget {
+  const WelcomeCard({super.key});
+
+  @override
+  Widget build(BuildContext context) {
+    final l10n = AppLocalizations.of(context)!;
+    final theme = Theme.of(context);
+
+    return Card(
+      child: Padding(
+        padding: const EdgeInsets.all(16),
+        child: Column(
+          crossAxisAlignment: CrossAxisAlignment.start,
+          children: [
+            Text(l10n.homeWelcomeTitle, style: theme.textTheme.headlineSmall),
+            const SizedBox(height: 8),
+            Text(l10n.homeWelcomeMessage, style: theme.textTheme.bodyMedium),
+          ],
+        ),
+      ),
+    );
+  }
+}
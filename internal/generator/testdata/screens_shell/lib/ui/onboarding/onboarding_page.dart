@@ -0,0 +1,34 @@
+import 'package:auto_route/auto_route.dart';
+import 'package:flutter/material.dart';
+import 'package:flutter_bloc/flutter_bloc.dart';
+import 'package:demo_app/repositories/onboarding_repository.dart';
+import 'package:demo_app/routers/app_router.gr.dart';
+import 'package:demo_app/state_management/cubit/onboarding/onboarding_cubit.dart';
+import 'package:demo_app/ui/onboarding/widgets/onboarding_carousel.dart';
+
+// Introduces the app on the first launch, then opens the home screen
+@RoutePage()
+class OnboardingPage extends StatelessWidget {
+  const OnboardingPage({super.key});
+
+  @override
+  Widget build(BuildContext context) {
+    return BlocProvider(
+      create: (context) => OnboardingCubit(
+        repository: context.read<OnboardingRepository>(),
+      ),
+      child: BlocListener<OnboardingCubit, OnboardingState>(
+        listenWhen: (previous, current) =>
+            current.completed && !previous.completed,
+        listener: (context, state) {
+          context.router.replaceAll([
+            const AppShellRoute(),
+          ]);
+        },
+        child: const Scaffold(
+          body: SafeArea(child: OnboardingCarousel()),
+        ),
+      ),
+    );
+  }
+}
@@ -0,0 +1,67 @@
+import 'package:flutter/material.dart';
+import 'package:flutter_bloc/flutter_bloc.dart';
+import 'package:demo_app/l10n/app_localizations.dart';
+import 'package:demo_app/state_management/cubit/onboarding/onboarding_cubit.dart';
+import 'package:demo_app/ui/onboarding/widgets/onboarding_controls.dart';
+import 'package:demo_app/ui/onboarding/widgets/onboarding_slide.dart';
+
+// Slides of the onboarding, swiped or moved with the controls. The state
+// only keeps the PageController, the current slide is in OnboardingCubit.
+class OnboardingCarousel extends StatefulWidget {
+  const OnboardingCarousel({super.key});
+
+  @override
+  State<OnboardingCarousel> createState() => _OnboardingCarouselState();
+}
+
+class _OnboardingCarouselState extends State<OnboardingCarousel> {
+  final _controller = PageController();
+
+  @override
+  void dispose() {
+    _controller.dispose();
+    super.dispose();
+  }
+
+  void _next() {
+    _controller.nextPage(
+      duration: const Duration(milliseconds: 300),
+      curve: Curves.easeInOut,
+    );
+  }
+
+  @override
+  Widget build(BuildContext context) {
+    final l10n = AppLocalizations.of(context)!;
+    final slides = [
+      OnboardingSlide(
+        icon: Icons.waving_hand_outlined,
+        title: l10n.onboardingWelcomeTitle,
+        body: l10n.onboardingWelcomeBody,
+      ),
+      OnboardingSlide(
+        icon: Icons.sync,
+        title: l10n.onboardingSyncTitle,
+        body: l10n.onboardingSyncBody,
+      ),
+      OnboardingSlide(
+        icon: Icons.rocket_launch_outlined,
+        title: l10n.onboardingStartTitle,
+        body: l10n.onboardingStartBody,
+      ),
+    ];
+
+    return Column(
+      children: [
+        Expanded(
+          child: PageView(
+            controller: _controller,
+            onPageChanged: context.read<OnboardingCubit>().pageChanged,
+            children: slides,
+          ),
+        ),
+        OnboardingControls(pageCount: slides.length, onNext: _next),
+      ],
+    );
+  }
+}
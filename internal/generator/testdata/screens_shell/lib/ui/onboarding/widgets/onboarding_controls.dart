@@ -0,0 +1,53 @@
+import 'package:flutter/material.dart';
+import 'package:flutter_bloc/flutter_bloc.dart';
+import 'package:demo_app/l10n/app_localizations.dart';
+import 'package:demo_app/state_management/cubit/onboarding/onboarding_cubit.dart';
+import 'package:demo_app/ui/onboarding/widgets/page_indicator.dart';
+
+// Skip button, page indicator and next button below the onboarding slides.
+// The next button completes the onboarding on the last slide.
+class OnboardingControls extends StatelessWidget {
+  final int pageCount;
+  final VoidCallback onNext;
+
+  const OnboardingControls({
+    super.key,
+    required this.pageCount,
+    required this.onNext,
+  });
+
+  @override
+  Widget build(BuildContext context) {
+    final l10n = AppLocalizations.of(context)!;
+
+    return BlocBuilder<OnboardingCubit, OnboardingState>(
+      builder: (context, state) {
+        final cubit = context.read<OnboardingCubit>();
+        final last = state.page == pageCount - 1;
+
+        return Padding(
+          padding: const EdgeInsets.all(16),
+          child: Row(
+            mainAxisAlignment: MainAxisAlignment.spaceBetween,
+            children: [
+              Visibility.maintain(
+                visible: !last,
+                child: TextButton(
+                  onPressed: cubit.complete,
+                  child: Text(l10n.onboardingSkipButton),
+                ),
+              ),
+              PageIndicator(count: pageCount, current: state.page),
+              FilledButton(
+                onPressed: last ? cubit.complete : onNext,
+                child: Text(
+                  last ? l10n.onboardingDoneButton : l10n.onboardingNextButton,
+                ),
+              ),
+            ],
+          ),
+        );
+      },
+    );
+  }
+}
@@ -0,0 +1,45 @@
+import 'package:flutter/material.dart';
+import 'package:demo_app/l10n/app_localizations.dart';
+
+// Information about the app and its policies
+class AboutSettings extends StatelessWidget {
+  const AboutSettings({super.key});
+
+  @override
+  Widget build(BuildContext context) {
+    final l10n = AppLocalizations.of(context)!;
+
+    return Column(
+      children: [
+        AboutListTile(
+          icon: const Icon(Icons.info),
+          applicationName: l10n.appTitle,
+        ),
+        ListTile(
+          leading: const Icon(Icons.description),
+          title: Text(l10n.termsOfService),
+          trailing: const Icon(Icons.chevron_right),
+          onTap: () {
+            // TODO: Show terms
+          },
+        ),
+        ListTile(
+          leading: const Icon(Icons.privacy_tip),
+          title: Text(l10n.privacyPolicy),
+          trailing: const Icon(Icons.chevron_right),
+          onTap: () {
+            // TODO: Show privacy policy
+          },
+        ),
+        ListTile(
+          leading: const Icon(Icons.help),
+          title: Text(l10n.helpAndSupport),
+          trailing: const Icon(Icons.chevron_right),
+          onTap: () {
+            // TODO: Show help
+          },
+        ),
+      ],
+    );
+  }
+}
@@ -0,0 +1,46 @@
+import 'package:flutter/material.dart';
+import 'package:flutter_bloc/flutter_bloc.dart';
+import 'package:demo_app/l10n/app_localizations.dart';
+import 'package:demo_app/state_management/cubit/settings/settings_cubit.dart';
+
+// Switches of the preferences held by SettingsCubit
+class GeneralSettings extends StatelessWidget {
+  const GeneralSettings({super.key});
+
+  @override
+  Widget build(BuildContext context) {
+    final l10n = AppLocalizations.of(context)!;
+
+    return BlocBuilder<SettingsCubit, SettingsState>(
+      builder: (context, state) {
+        final cubit = context.read<SettingsCubit>();
+
+        return Column(
+          children: [
+            SwitchListTile(
+              secondary: const Icon(Icons.notifications),
+              title: Text(l10n.settingsNotifications),
+              subtitle: Text(l10n.settingsNotificationsDescription),
+              value: state.notificationsEnabled,
+              onChanged: cubit.toggleNotifications,
+            ),
+            SwitchListTile(
+              secondary: const Icon(Icons.dark_mode),
+              title: Text(l10n.settingsDarkMode),
+              subtitle: Text(l10n.settingsDarkModeDescription),
+              value: state.darkModeEnabled,
+              onChanged: cubit.toggleDarkMode,
+            ),
+            SwitchListTile(
+              secondary: const Icon(Icons.sync),
+              title: Text(l10n.settingsAutoSync),
+              subtitle: Text(l10n.settingsAutoSyncDescription),
+              value: state.autoSyncEnabled,
+              onChanged: cubit.toggleAutoSync,
+            ),
+          ],
+        );
+      },
+    );
+  }
+}
@@ -0,0 +1,36 @@
+import 'package:auto_route/auto_route.dart';
+import 'package:flutter/material.dart';
+import 'package:flutter_bloc/flutter_bloc.dart';
+import 'package:demo_app/repositories/onboarding_repository.dart';
+import 'package:demo_app/routers/app_router.gr.dart';
+import 'package:demo_app/state_management/cubit/splash/splash_cubit.dart';
+import 'package:demo_app/ui/splash/widgets/splash_view.dart';
+
+// Shown while the app starts, then opens the onboarding until it is
+// completed and the home screen afterwards
+@RoutePage()
+class SplashPage extends StatelessWidget {
+  const SplashPage({super.key});
+
+  @override
+  Widget build(BuildContext context) {
+    return BlocProvider(
+      create: (context) => SplashCubit(
+        onboarding: context.read<OnboardingRepository>(),
+      )..start(),
+      child: BlocListener<SplashCubit, SplashDestination?>(
+        listener: (context, destination) {
+          final route = switch (destination) {
+            SplashDestination.onboarding => const OnboardingRoute(),
+            SplashDestination.main => const AppShellRoute(),
+            null => null,
+          };
+          if (route != null) {
+            context.router.replaceAll([route]);
+          }
+        },
+        child: const SplashView(),
+      ),
+    );
+  }
+}
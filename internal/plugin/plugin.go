@@ -0,0 +1,251 @@
+package plugin
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"fline-cli/internal/config"
+	"fline-cli/internal/utils"
+)
+
+const (
+	// Prefix is the executable name prefix of every plugin (fline-<name>)
+	Prefix = "fline-"
+
+	// ProjectPluginsDir is where project-local plugins live, relative to the project root
+	ProjectPluginsDir = ".fline/plugins"
+
+	// ProtocolVersion is sent to plugins so they can detect incompatible changes
+	ProtocolVersion = 1
+)
+
+// Plugin is an external generator executable
+type Plugin struct {
+	Name string // Subcommand name, e.g. "analytics" for fline-analytics
+	Path string // Absolute path of the executable
+}
+
+// Context is written as JSON to the plugin's stdin
+type Context struct {
+	ProtocolVersion int         `json:"protocolVersion"`
+	Command         string      `json:"command"`
+	Args            []string    `json:"args"`
+	Project         ProjectInfo `json:"project"`
+
+	// Config is the configuration fline create would have used for the
+	// project, rebuilt from it: name, organization, backends with their
+	// modules and notifications
+	Config *config.ProjectConfig `json:"config"`
+}
+
+// ProjectInfo describes the Flutter project the plugin runs against
+type ProjectInfo struct {
+	Root        string `json:"root"`
+	PackageName string `json:"packageName"`
+	Pubspec     string `json:"pubspec"`
+	Lib         string `json:"lib"`
+}
+
+// Response is read as JSON from the plugin's stdout
+type Response struct {
+	Files    []File    `json:"files"`
+	Messages []Message `json:"messages"`
+}
+
+// File is a file the plugin wants written, relative to the project root
+type File struct {
+	Path    string `json:"path"`
+	Content string `json:"content"`
+}
+
+// Message is shown to the user after the plugin runs
+type Message struct {
+	Level string `json:"level"` // "info", "success" or "warning"
+	Text  string `json:"text"`
+}
+
+// searchDirs returns the directories plugins are looked up in, in order of
+// precedence
+func searchDirs(projectRoot string) []string {
+	dirs := []string{filepath.Join(projectRoot, ProjectPluginsDir)}
+	return append(dirs, filepath.SplitList(os.Getenv("PATH"))...)
+}
+
+// Find looks up the plugin called name, the project's first. Unlike
+// Discover, it doesn't list the directories.
+func Find(projectRoot, name string) (Plugin, bool) {
+	file := Prefix + name
+	if runtime.GOOS == "windows" {
+		file += ".exe"
+	}
+
+	for _, dir := range searchDirs(projectRoot) {
+		path := filepath.Join(dir, file)
+		if !isExecutable(path) {
+			continue
+		}
+		if abs, err := filepath.Abs(path); err == nil {
+			path = abs
+		}
+		return Plugin{Name: name, Path: path}, true
+	}
+	return Plugin{}, false
+}
+
+// Discover finds plugins in the project's .fline/plugins directory and on
+// PATH. Project plugins take precedence over PATH plugins of the same name.
+func Discover(projectRoot string) []Plugin {
+	found := map[string]Plugin{}
+
+	for _, dir := range searchDirs(projectRoot) {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+
+		for _, entry := range entries {
+			name, ok := pluginName(entry.Name())
+			if !ok || entry.IsDir() {
+				continue
+			}
+			if _, exists := found[name]; exists {
+				continue
+			}
+
+			path := filepath.Join(dir, entry.Name())
+			if !isExecutable(path) {
+				continue
+			}
+			if abs, err := filepath.Abs(path); err == nil {
+				path = abs
+			}
+
+			found[name] = Plugin{Name: name, Path: path}
+		}
+	}
+
+	plugins := make([]Plugin, 0, len(found))
+	for _, p := range found {
+		plugins = append(plugins, p)
+	}
+	sort.Slice(plugins, func(i, j int) bool {
+		return plugins[i].Name < plugins[j].Name
+	})
+
+	return plugins
+}
+
+// Run executes the plugin with ctx on stdin and returns its response.
+// The plugin's stderr is passed through to the user.
+func (p Plugin) Run(ctx Context) (*Response, error) {
+	input, err := json.Marshal(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode plugin context: %w", err)
+	}
+
+	var stdout bytes.Buffer
+	cmd := exec.Command(p.Path, ctx.Args...)
+	cmd.Dir = ctx.Project.Root
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(), "FLINE_PLUGIN_PROTOCOL="+fmt.Sprint(ProtocolVersion))
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("plugin %s failed: %w", p.Name, err)
+	}
+
+	var resp Response
+	if strings.TrimSpace(stdout.String()) == "" {
+		return &resp, nil
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &resp); err != nil {
+		return nil, fmt.Errorf("plugin %s returned invalid JSON: %w", p.Name, err)
+	}
+
+	for _, f := range resp.Files {
+		if err := validatePath(f.Path); err != nil {
+			return nil, fmt.Errorf("plugin %s: %w", p.Name, err)
+		}
+	}
+
+	return &resp, nil
+}
+
+// validatePath rejects paths that would escape the project root
+func validatePath(path string) error {
+	if path == "" {
+		return fmt.Errorf("file with empty path")
+	}
+	if filepath.IsAbs(path) {
+		return fmt.Errorf("file path %s must be relative to the project root", path)
+	}
+	clean := filepath.Clean(filepath.FromSlash(path))
+	if clean == ".." || strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("file path %s is outside the project", path)
+	}
+	return nil
+}
+
+// ExtractConflictPolicy removes the --on-conflict flag from the plugin
+// arguments, since those are otherwise passed through untouched. Existing
+// files are kept unless the flag says otherwise.
+func ExtractConflictPolicy(args []string) (utils.ConflictPolicy, []string, error) {
+	policy := utils.ConflictSkip
+	rest := []string{}
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--on-conflict":
+			if i+1 >= len(args) {
+				return policy, nil, fmt.Errorf("--on-conflict requires a value")
+			}
+			i++
+			p, err := utils.ParseConflictPolicy(args[i])
+			if err != nil {
+				return policy, nil, err
+			}
+			policy = p
+		case strings.HasPrefix(arg, "--on-conflict="):
+			p, err := utils.ParseConflictPolicy(strings.TrimPrefix(arg, "--on-conflict="))
+			if err != nil {
+				return policy, nil, err
+			}
+			policy = p
+		default:
+			rest = append(rest, arg)
+		}
+	}
+
+	return policy, rest, nil
+}
+
+func pluginName(file string) (string, bool) {
+	if !strings.HasPrefix(file, Prefix) {
+		return "", false
+	}
+	name := strings.TrimPrefix(file, Prefix)
+	if runtime.GOOS == "windows" {
+		name = strings.TrimSuffix(name, ".exe")
+	}
+	return name, name != ""
+}
+
+func isExecutable(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil || info.IsDir() {
+		return false
+	}
+	if runtime.GOOS == "windows" {
+		return strings.HasSuffix(strings.ToLower(path), ".exe")
+	}
+	return info.Mode().Perm()&0111 != 0
+}
@@ -0,0 +1,68 @@
+package plugin
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"fline-cli/internal/utils"
+)
+
+func TestValidatePath(t *testing.T) {
+	tests := []struct {
+		path    string
+		wantErr bool
+	}{
+		{"lib/analytics/events.dart", false},
+		{"./README.md", false},
+		{"lib/../test/events_test.dart", false},
+		{"", true},
+		{"..", true},
+		{"../outside.dart", true},
+		{"lib/../../outside.dart", true},
+		{filepath.Join(string(filepath.Separator), "etc", "passwd"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			err := validatePath(tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validatePath(%q) error = %v, wantErr %v", tt.path, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestExtractConflictPolicy(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		want     utils.ConflictPolicy
+		wantRest []string
+		wantErr  bool
+	}{
+		{"default skips", []string{"--event", "purchase"}, utils.ConflictSkip, []string{"--event", "purchase"}, false},
+		{"separate value", []string{"--on-conflict", "overwrite", "x"}, utils.ConflictOverwrite, []string{"x"}, false},
+		{"inline value", []string{"x", "--on-conflict=fail"}, utils.ConflictFail, []string{"x"}, false},
+		{"missing value", []string{"--on-conflict"}, utils.ConflictSkip, nil, true},
+		{"unknown value", []string{"--on-conflict=merge"}, utils.ConflictSkip, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, rest, err := ExtractConflictPolicy(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExtractConflictPolicy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if policy != tt.want {
+				t.Errorf("policy = %v, want %v", policy, tt.want)
+			}
+			if !reflect.DeepEqual(rest, tt.wantRest) {
+				t.Errorf("rest = %v, want %v", rest, tt.wantRest)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+//go:build linux
+
+package plugin
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// writeScript writes an executable shell script
+func writeScript(t *testing.T, path, body string, mode os.FileMode) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("#!/bin/sh\n"+body), mode); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDiscoverAndFind(t *testing.T) {
+	project := t.TempDir()
+	pathDir := t.TempDir()
+	t.Setenv("PATH", pathDir)
+
+	projectPlugins := filepath.Join(project, ProjectPluginsDir)
+	writeScript(t, filepath.Join(projectPlugins, "fline-analytics"), "", 0755)
+	writeScript(t, filepath.Join(projectPlugins, "fline-notes"), "", 0644) // Not executable
+	writeScript(t, filepath.Join(projectPlugins, "other"), "", 0755)
+	writeScript(t, filepath.Join(pathDir, "fline-analytics"), "", 0755) // Shadowed by the project
+	writeScript(t, filepath.Join(pathDir, "fline-lint"), "", 0755)
+	if err := os.MkdirAll(filepath.Join(pathDir, "fline-dir"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Plugin{
+		{Name: "analytics", Path: filepath.Join(projectPlugins, "fline-analytics")},
+		{Name: "lint", Path: filepath.Join(pathDir, "fline-lint")},
+	}
+	if got := Discover(project); !reflect.DeepEqual(got, want) {
+		t.Errorf("Discover() = %v, want %v", got, want)
+	}
+
+	tests := []struct {
+		name   string
+		want   Plugin
+		wantOK bool
+	}{
+		{"analytics", want[0], true},
+		{"lint", want[1], true},
+		{"notes", Plugin{}, false},
+		{"dir", Plugin{}, false},
+		{"missing", Plugin{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Find(project, tt.name)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("Find() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name    string
+		script  string
+		want    *Response
+		wantErr bool
+	}{
+		{
+			name:   "files and messages",
+			script: `echo '{"files": [{"path": "lib/a.dart", "content": "// a"}], "messages": [{"level": "success", "text": "done"}]}'`,
+			want: &Response{
+				Files:    []File{{Path: "lib/a.dart", Content: "// a"}},
+				Messages: []Message{{Level: "success", Text: "done"}},
+			},
+		},
+		{
+			name:   "no output",
+			script: "true",
+			want:   &Response{},
+		},
+		{
+			name:    "invalid JSON",
+			script:  "echo 'not json'",
+			wantErr: true,
+		},
+		{
+			name:    "path outside the project",
+			script:  `echo '{"files": [{"path": "../escape.dart", "content": ""}]}'`,
+			wantErr: true,
+		},
+		{
+			name:    "absolute path",
+			script:  `echo '{"files": [{"path": "/tmp/escape.dart", "content": ""}]}'`,
+			wantErr: true,
+		},
+		{
+			name:    "failure",
+			script:  "exit 1",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			path := filepath.Join(t.TempDir(), "fline-test")
+			writeScript(t, path, "cat > /dev/null\n"+tt.script+"\n", 0755)
+
+			got, err := Plugin{Name: "test", Path: path}.Run(Context{Project: ProjectInfo{Root: root}})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Run() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Run() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// The plugin runs in the project root with the context on stdin and its
+// arguments on the command line
+func TestRunSendsContext(t *testing.T) {
+	root := t.TempDir()
+	path := filepath.Join(t.TempDir(), "fline-test")
+	writeScript(t, path, "cat > context.json\necho \"$@\" > args.txt\n", 0755)
+
+	ctx := Context{
+		ProtocolVersion: ProtocolVersion,
+		Command:         "test",
+		Args:            []string{"--event", "purchase"},
+		Project:         ProjectInfo{Root: root, PackageName: "demo_app"},
+	}
+	if _, err := (Plugin{Name: "test", Path: path}).Run(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	content, err := os.ReadFile(filepath.Join(root, "context.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got Context
+	if err := json.Unmarshal(content, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, ctx) {
+		t.Errorf("context = %+v, want %+v", got, ctx)
+	}
+
+	args, _ := os.ReadFile(filepath.Join(root, "args.txt"))
+	if string(args) != "--event purchase\n" {
+		t.Errorf("args = %q", args)
+	}
+}
@@ -0,0 +1,301 @@
+package pubspec
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// indentUnit is the indentation used for entries added to a new section
+const indentUnit = "  "
+
+// Set sets a scalar value, e.g. Set([]string{"flutter", "generate"}, true),
+// creating the parent mappings when needed. It reports whether the file
+// changed.
+func (p *Pubspec) Set(path []string, value interface{}) (bool, error) {
+	rendered, err := formatScalar(value)
+	if err != nil {
+		return false, err
+	}
+
+	_, node := p.lookup(path)
+	if node != nil && node.Kind == yaml.ScalarNode && node.Value == fmt.Sprint(value) {
+		return false, nil
+	}
+
+	return true, p.setEntry(path, []string{path[len(path)-1] + ": " + rendered})
+}
+
+// Value returns the scalar value at path
+func (p *Pubspec) Value(path []string) (string, bool) {
+	_, node := p.lookup(path)
+	if node == nil || node.Kind != yaml.ScalarNode {
+		return "", false
+	}
+	return node.Value, true
+}
+
+// SetDependency adds dep to a section or updates its constraint or source.
+// It reports whether the file changed.
+func (p *Pubspec) SetDependency(section string, dep Dependency) (bool, error) {
+	if current, ok := p.Dependency(section, dep.Name); ok && reflect.DeepEqual(current, dep) {
+		return false, nil
+	}
+
+	lines, err := dependencyLines(dep)
+	if err != nil {
+		return false, err
+	}
+	return true, p.setEntry([]string{section, dep.Name}, lines)
+}
+
+// RemoveDependency removes a dependency from a section. It reports whether
+// the dependency was there.
+func (p *Pubspec) RemoveDependency(section, name string) (bool, error) {
+	key, _ := p.lookup([]string{section, name})
+	if key == nil {
+		return false, nil
+	}
+
+	start := key.Line - 1
+	p.lines = append(p.lines[:start], p.lines[p.blockEnd(start, key.Column-1)+1:]...)
+	return true, p.parse()
+}
+
+// Assets returns the entries of flutter.assets
+func (p *Pubspec) Assets() []string {
+	_, node := p.lookup([]string{"flutter", "assets"})
+	if node == nil || node.Kind != yaml.SequenceNode {
+		return nil
+	}
+
+	var assets []string
+	for _, item := range node.Content {
+		if item.Kind == yaml.ScalarNode {
+			assets = append(assets, item.Value)
+		}
+	}
+	return assets
+}
+
+// AddAsset adds an asset path to flutter.assets. It reports whether the
+// file changed.
+func (p *Pubspec) AddAsset(asset string) (bool, error) {
+	for _, a := range p.Assets() {
+		if a == asset {
+			return false, nil
+		}
+	}
+
+	item, err := formatScalar(asset)
+	if err != nil {
+		return false, err
+	}
+
+	path := []string{"flutter", "assets"}
+	key, node := p.lookup(path)
+
+	switch {
+	case key == nil || node.Kind != yaml.SequenceNode || len(node.Content) == 0:
+		// No assets yet: (re)write the key with a one-item list
+		return true, p.setEntry(path, []string{"assets:", indentUnit + "- " + item})
+	default:
+		last := node.Content[len(node.Content)-1]
+		line := p.lines[last.Line-1]
+		dash := line[:strings.Index(line, "-")]
+		p.insert(last.Line-1, []string{dash + "- " + item})
+		return true, p.parse()
+	}
+}
+
+// RemoveAsset removes an asset path from flutter.assets. It reports whether
+// the asset was there.
+func (p *Pubspec) RemoveAsset(asset string) (bool, error) {
+	_, node := p.lookup([]string{"flutter", "assets"})
+	if node == nil || node.Kind != yaml.SequenceNode {
+		return false, nil
+	}
+
+	for _, item := range node.Content {
+		if item.Kind == yaml.ScalarNode && item.Value == asset {
+			start := item.Line - 1
+			p.lines = append(p.lines[:start], p.lines[start+1:]...)
+			return true, p.parse()
+		}
+	}
+	return false, nil
+}
+
+// lookup returns the key and value nodes at path
+func (p *Pubspec) lookup(path []string) (*yaml.Node, *yaml.Node) {
+	var key *yaml.Node
+	node := p.root
+	for _, name := range path {
+		key, node = mappingEntry(node, name)
+		if key == nil {
+			return nil, nil
+		}
+	}
+	return key, node
+}
+
+// mappingEntry returns the key and value nodes of name in a mapping node
+func mappingEntry(node *yaml.Node, name string) (*yaml.Node, *yaml.Node) {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil, nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == name {
+			return node.Content[i], node.Content[i+1]
+		}
+	}
+	return nil, nil
+}
+
+// setEntry replaces the entry at path with lines (rendered without
+// indentation), or inserts it after the last entry of its parent. Missing
+// parents are created.
+func (p *Pubspec) setEntry(path []string, lines []string) error {
+	if key, value := p.lookup(path); key != nil {
+		// Keep a comment at the end of a single-line entry,
+		// e.g. "dio: ^5.0.0 # pinned"
+		comment := key.LineComment
+		if comment == "" && value.Line == key.Line {
+			comment = value.LineComment
+		}
+		if comment != "" && len(lines) == 1 {
+			lines = []string{lines[0] + " " + comment}
+		}
+
+		start := key.Line - 1
+		indent := strings.Repeat(" ", key.Column-1)
+		end := p.blockEnd(start, key.Column-1)
+		p.lines = append(p.lines[:start], append(indented(indent, lines), p.lines[end+1:]...)...)
+		return p.parse()
+	}
+
+	// Wrap the entry in the parents that don't exist yet
+	depth := len(path) - 1
+	for depth > 0 {
+		if key, node := p.lookup(path[:depth]); key != nil && (node.Kind == yaml.MappingNode || isEmpty(node)) {
+			break
+		}
+		lines = append([]string{path[depth-1] + ":"}, indented(indentUnit, lines)...)
+		depth--
+	}
+
+	if depth == 0 {
+		// New top-level entry at the end of the file
+		if n := len(p.lines); n > 0 && strings.TrimSpace(p.lines[n-1]) != "" {
+			p.lines = append(p.lines, "")
+		}
+		p.lines = append(p.lines, lines...)
+		return p.parse()
+	}
+
+	parentKey, parent := p.lookup(path[:depth])
+	if parent.Kind == yaml.MappingNode && len(parent.Content) == 0 {
+		// Flow-style empty mapping, e.g. "dependency_overrides: {}"
+		return p.setEntry(path[:depth], append([]string{path[depth-1] + ":"}, indented(indentUnit, lines)...))
+	}
+	if isEmpty(parent) {
+		// "section:" with no entries yet
+		indent := strings.Repeat(" ", parentKey.Column-1) + indentUnit
+		p.insert(parentKey.Line-1, indented(indent, lines))
+		return p.parse()
+	}
+
+	lastKey := parent.Content[len(parent.Content)-2]
+	indent := strings.Repeat(" ", lastKey.Column-1)
+	p.insert(p.blockEnd(lastKey.Line-1, lastKey.Column-1), indented(indent, lines))
+	return p.parse()
+}
+
+// blockEnd returns the index of the last line of the entry starting at line
+// start with the given indentation. Trailing blank lines and comments belong
+// to whatever follows.
+func (p *Pubspec) blockEnd(start, indent int) int {
+	end := start
+	for i := start + 1; i < len(p.lines); i++ {
+		trimmed := strings.TrimSpace(p.lines[i])
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+
+		lineIndent := len(p.lines[i]) - len(strings.TrimLeft(p.lines[i], " "))
+		// Sequence items may be at the same indentation as their key
+		isItem := lineIndent == indent && (trimmed == "-" || strings.HasPrefix(trimmed, "- "))
+		if lineIndent <= indent && !isItem {
+			break
+		}
+		end = i
+	}
+	return end
+}
+
+// insert inserts lines after the line at index after
+func (p *Pubspec) insert(after int, lines []string) {
+	rest := append([]string{}, p.lines[after+1:]...)
+	p.lines = append(append(p.lines[:after+1], lines...), rest...)
+}
+
+func indented(indent string, lines []string) []string {
+	out := make([]string, len(lines))
+	for i, line := range lines {
+		out[i] = indent + line
+	}
+	return out
+}
+
+// isEmpty reports whether node is a missing value, e.g. "dev_dependencies:"
+func isEmpty(node *yaml.Node) bool {
+	return node.Kind == yaml.ScalarNode && node.Tag == "!!null"
+}
+
+// dependencyLines renders a dependency entry
+func dependencyLines(dep Dependency) ([]string, error) {
+	var fields [][2]string
+	switch {
+	case dep.SDK != "":
+		fields = append(fields, [2]string{"sdk", dep.SDK})
+	case dep.Path != "":
+		fields = append(fields, [2]string{"path", dep.Path})
+	case dep.Git != "":
+		fields = append(fields, [2]string{"git", dep.Git})
+	default:
+		version := dep.Version
+		if version == "" {
+			version = "any"
+		}
+		rendered, err := formatScalar(version)
+		if err != nil {
+			return nil, err
+		}
+		return []string{dep.Name + ": " + rendered}, nil
+	}
+
+	lines := []string{dep.Name + ":"}
+	for _, f := range fields {
+		rendered, err := formatScalar(f[1])
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, indentUnit+f[0]+": "+rendered)
+	}
+	return lines, nil
+}
+
+// formatScalar renders a scalar value, quoting it when YAML requires it
+func formatScalar(value interface{}) (string, error) {
+	out, err := yaml.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	rendered := strings.TrimSuffix(string(out), "\n")
+	if strings.Contains(rendered, "\n") {
+		return "", fmt.Errorf("%q does not fit on a single line", value)
+	}
+	return rendered, nil
+}
@@ -0,0 +1,194 @@
+package pubspec
+
+import (
+	"testing"
+)
+
+const flutterCreatePubspec = `name: demo_app
+description: "A new Flutter project."
+# Prevent accidental publishing to pub.dev.
+publish_to: 'none'
+
+version: 1.0.0+1
+
+environment:
+  sdk: ^3.8.1
+
+dependencies:
+  flutter:
+    sdk: flutter
+
+  # The following adds the Cupertino Icons font to your application.
+  cupertino_icons: ^1.0.8
+
+dev_dependencies:
+  flutter_test:
+    sdk: flutter
+
+  flutter_lints: ^5.0.0 # keep in sync with analysis_options
+
+# The following section is specific to Flutter packages.
+flutter:
+
+  # The following line ensures that the Material Icons font is
+  # included with your application.
+  uses-material-design: true
+
+  # To add assets to your application, add an assets section, like this:
+  # assets:
+  #   - images/a_dot_burr.jpeg
+`
+
+func mustParse(t *testing.T, content string) *Pubspec {
+	t.Helper()
+	p, err := Parse([]byte(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func mustChange(t *testing.T, changed bool, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changed {
+		t.Fatal("edit reported no change")
+	}
+}
+
+func TestEditPreservesCommentsAndOrder(t *testing.T) {
+	p := mustParse(t, flutterCreatePubspec)
+
+	changed, err := p.Set([]string{"description"}, "A Pine app: with a colon")
+	mustChange(t, changed, err)
+	changed, err = p.SetDependency(SectionDependencies, Dependency{Name: "flutter_localizations", SDK: "flutter"})
+	mustChange(t, changed, err)
+	changed, err = p.SetDependency(SectionDependencies, Dependency{Name: "dio", Version: "^5.9.2"})
+	mustChange(t, changed, err)
+	changed, err = p.SetDependency(SectionDevDependencies, Dependency{Name: "flutter_lints", Version: "^6.0.0"})
+	mustChange(t, changed, err)
+	changed, err = p.SetDependency(SectionDependencyOverrides, Dependency{Name: "intl", Version: ">=0.19.0 <0.21.0"})
+	mustChange(t, changed, err)
+	changed, err = p.Set([]string{"flutter", "generate"}, true)
+	mustChange(t, changed, err)
+	changed, err = p.AddAsset("assets/images/")
+	mustChange(t, changed, err)
+	changed, err = p.AddAsset("assets/fonts/")
+	mustChange(t, changed, err)
+	changed, err = p.RemoveDependency(SectionDependencies, "cupertino_icons")
+	mustChange(t, changed, err)
+
+	want := `name: demo_app
+description: 'A Pine app: with a colon'
+# Prevent accidental publishing to pub.dev.
+publish_to: 'none'
+
+version: 1.0.0+1
+
+environment:
+  sdk: ^3.8.1
+
+dependencies:
+  flutter:
+    sdk: flutter
+
+  # The following adds the Cupertino Icons font to your application.
+  flutter_localizations:
+    sdk: flutter
+  dio: ^5.9.2
+
+dev_dependencies:
+  flutter_test:
+    sdk: flutter
+
+  flutter_lints: ^6.0.0 # keep in sync with analysis_options
+
+# The following section is specific to Flutter packages.
+flutter:
+
+  # The following line ensures that the Material Icons font is
+  # included with your application.
+  uses-material-design: true
+  generate: true
+  assets:
+    - assets/images/
+    - assets/fonts/
+
+  # To add assets to your application, add an assets section, like this:
+  # assets:
+  #   - images/a_dot_burr.jpeg
+
+dependency_overrides:
+  intl: '>=0.19.0 <0.21.0'
+`
+	if got := string(p.Bytes()); got != want {
+		t.Errorf("edited pubspec:\n%s\nwant:\n%s", got, want)
+	}
+
+	// The result must parse back to the same model
+	reparsed := mustParse(t, string(p.Bytes()))
+	if dep, _ := reparsed.Dependency(SectionDependencyOverrides, "intl"); dep.Version != ">=0.19.0 <0.21.0" {
+		t.Errorf("override = %+v", dep)
+	}
+	if assets := reparsed.Assets(); len(assets) != 2 {
+		t.Errorf("Assets() = %v", assets)
+	}
+}
+
+func TestEditsAreIdempotent(t *testing.T) {
+	p := mustParse(t, flutterCreatePubspec)
+
+	edits := []func() (bool, error){
+		func() (bool, error) {
+			return p.SetDependency(SectionDependencies, Dependency{Name: "flutter", SDK: "flutter"})
+		},
+		func() (bool, error) {
+			return p.SetDependency(SectionDependencies, Dependency{Name: "cupertino_icons", Version: "^1.0.8"})
+		},
+		func() (bool, error) { return p.Set([]string{"flutter", "uses-material-design"}, true) },
+		func() (bool, error) { return p.RemoveDependency(SectionDependencies, "missing") },
+		func() (bool, error) { return p.RemoveAsset("missing/") },
+	}
+	for i, edit := range edits {
+		changed, err := edit()
+		if err != nil || changed {
+			t.Errorf("edit %d: changed = %v, err = %v; want no change", i, changed, err)
+		}
+	}
+
+	if got := string(p.Bytes()); got != flutterCreatePubspec {
+		t.Errorf("pubspec changed:\n%s", got)
+	}
+}
+
+func TestEditEmptySections(t *testing.T) {
+	p := mustParse(t, "name: app\ndependencies:\ndev_dependencies: {}\n")
+
+	changed, err := p.SetDependency(SectionDependencies, Dependency{Name: "dio", Version: "^5.9.2"})
+	mustChange(t, changed, err)
+	changed, err = p.SetDependency(SectionDevDependencies, Dependency{Name: "local", Path: "../local"})
+	mustChange(t, changed, err)
+	changed, err = p.RemoveAsset("none")
+	if changed || err != nil {
+		t.Errorf("RemoveAsset() = %v, %v", changed, err)
+	}
+	changed, err = p.AddAsset("assets/")
+	mustChange(t, changed, err)
+
+	want := `name: app
+dependencies:
+  dio: ^5.9.2
+dev_dependencies:
+  local:
+    path: ../local
+
+flutter:
+  assets:
+    - assets/
+`
+	if got := string(p.Bytes()); got != want {
+		t.Errorf("edited pubspec:\n%s\nwant:\n%s", got, want)
+	}
+}
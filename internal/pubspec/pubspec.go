@@ -0,0 +1,207 @@
+// Package pubspec reads and edits the pubspec.yaml of a Dart package.
+//
+// Edits are applied to the lines of the original file, so comments, blank
+// lines and the order of the entries are preserved.
+package pubspec
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"fline-cli/internal/utils"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Dependency sections of a pubspec
+const (
+	SectionDependencies        = "dependencies"
+	SectionDevDependencies     = "dev_dependencies"
+	SectionDependencyOverrides = "dependency_overrides"
+)
+
+// Dependency is a dependency entry. Hosted dependencies set Version (the
+// constraint, e.g. "^1.2.0"); SDK, path and git dependencies set the
+// corresponding field instead.
+type Dependency struct {
+	Name    string
+	Version string
+	SDK     string
+	Path    string
+	Git     string
+}
+
+// Pubspec is a parsed pubspec.yaml
+type Pubspec struct {
+	root  *yaml.Node // Mapping node of the document
+	lines []string   // Source lines, without the final newline
+}
+
+// Load reads and parses a pubspec.yaml file
+func Load(path string) (*Pubspec, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(content)
+}
+
+// Parse parses the content of a pubspec.yaml
+func Parse(content []byte) (*Pubspec, error) {
+	p := &Pubspec{}
+	text := strings.TrimRight(strings.ReplaceAll(string(content), "\r\n", "\n"), "\n")
+	if text != "" {
+		p.lines = strings.Split(text, "\n")
+	}
+	if err := p.parse(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// parse parses the current lines into the node tree
+func (p *Pubspec) parse() error {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(strings.Join(p.lines, "\n")), &doc); err != nil {
+		return fmt.Errorf("invalid pubspec.yaml: %w", err)
+	}
+
+	if len(doc.Content) == 0 {
+		p.root = &yaml.Node{Kind: yaml.MappingNode}
+		return nil
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return fmt.Errorf("invalid pubspec.yaml: expected a mapping at the top level")
+	}
+
+	p.root = root
+	return nil
+}
+
+// Bytes returns the content of the pubspec
+func (p *Pubspec) Bytes() []byte {
+	if len(p.lines) == 0 {
+		return nil
+	}
+	return []byte(strings.Join(p.lines, "\n") + "\n")
+}
+
+// Save writes the pubspec to path
+func (p *Pubspec) Save(path string) error {
+	return os.WriteFile(path, p.Bytes(), 0644)
+}
+
+// Name returns the package name
+func (p *Pubspec) Name() string {
+	if node := mappingValue(p.root, "name"); node != nil {
+		return node.Value
+	}
+	return ""
+}
+
+// Dependencies returns the entries of a section (e.g. SectionDependencies)
+// in file order
+func (p *Pubspec) Dependencies(section string) []Dependency {
+	node := mappingValue(p.root, section)
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	var deps []Dependency
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		deps = append(deps, parseDependency(node.Content[i].Value, node.Content[i+1]))
+	}
+	return deps
+}
+
+// Dependency looks up a dependency by name in a section
+func (p *Pubspec) Dependency(section, name string) (Dependency, bool) {
+	for _, dep := range p.Dependencies(section) {
+		if dep.Name == name {
+			return dep, true
+		}
+	}
+	return Dependency{}, false
+}
+
+// HasDependency reports whether name is a regular or dev dependency
+func (p *Pubspec) HasDependency(name string) bool {
+	_, ok := p.Dependency(SectionDependencies, name)
+	if !ok {
+		_, ok = p.Dependency(SectionDevDependencies, name)
+	}
+	return ok
+}
+
+func parseDependency(name string, node *yaml.Node) Dependency {
+	dep := Dependency{Name: name}
+
+	switch node.Kind {
+	case yaml.ScalarNode:
+		// "name:" with no value means any version
+		dep.Version = node.Value
+		if dep.Version == "" {
+			dep.Version = "any"
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i].Value, node.Content[i+1]
+			switch key {
+			case "sdk":
+				dep.SDK = value.Value
+			case "path":
+				dep.Path = value.Value
+			case "git":
+				dep.Git = value.Value
+				if url := mappingValue(value, "url"); url != nil {
+					dep.Git = url.Value
+				}
+			case "version":
+				dep.Version = value.Value
+			}
+		}
+	}
+
+	return dep
+}
+
+// mappingValue returns the value node of key in a mapping node
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// MinVersion returns the lowest version allowed by a hosted constraint such
+// as "^1.2.0", ">=1.2.0 <2.0.0" or "1.2.0". It returns false for "any" and
+// for constraints without a lower bound.
+func MinVersion(constraint string) (utils.Version, bool) {
+	for _, part := range strings.Fields(constraint) {
+		var raw string
+		switch {
+		case strings.HasPrefix(part, "^"):
+			raw = part[1:]
+		case strings.HasPrefix(part, ">="):
+			raw = part[2:]
+		case strings.HasPrefix(part, ">"):
+			raw = part[1:]
+		case strings.HasPrefix(part, "<"):
+			continue
+		default:
+			raw = part
+		}
+
+		if v, err := utils.ParseVersion(raw); err == nil {
+			return v, true
+		}
+	}
+	return utils.Version{}, false
+}
@@ -0,0 +1,70 @@
+package pubspec
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseDependencies(t *testing.T) {
+	spec, err := Parse([]byte(`name: demo_app # the package
+description: A demo
+
+dependencies:
+  flutter:
+    sdk: flutter
+  dio: ^5.9.2
+  local_pkg:
+    path: ../local_pkg
+  forked:
+    git:
+      url: https://example.com/forked.git
+  anything:
+
+dev_dependencies:
+  build_runner: '>=2.4.0 <3.0.0'
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if spec.Name() != "demo_app" {
+		t.Errorf("Name() = %q", spec.Name())
+	}
+
+	want := []Dependency{
+		{Name: "flutter", SDK: "flutter"},
+		{Name: "dio", Version: "^5.9.2"},
+		{Name: "local_pkg", Path: "../local_pkg"},
+		{Name: "forked", Git: "https://example.com/forked.git"},
+		{Name: "anything", Version: "any"},
+	}
+	if got := spec.Dependencies(SectionDependencies); !reflect.DeepEqual(got, want) {
+		t.Errorf("Dependencies() = %+v, want %+v", got, want)
+	}
+
+	if !spec.HasDependency("build_runner") || spec.HasDependency("mockito") {
+		t.Error("HasDependency() does not look at dev_dependencies correctly")
+	}
+}
+
+func TestMinVersion(t *testing.T) {
+	tests := []struct {
+		constraint string
+		want       string
+		ok         bool
+	}{
+		{"^1.2.3", "1.2.3", true},
+		{"^6.1.5+1", "6.1.5", true},
+		{">=2.4.0 <3.0.0", "2.4.0", true},
+		{"1.0.0", "1.0.0", true},
+		{"<2.0.0", "", false},
+		{"any", "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := MinVersion(tt.constraint)
+		if ok != tt.ok || (ok && got.String() != tt.want) {
+			t.Errorf("MinVersion(%q) = %s, %v; want %s, %v", tt.constraint, got, ok, tt.want, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package templates
+
+// Template describes a built-in template
+type Template struct {
+	Name        string // Lookup name, e.g. "model/bloc.dart"
+	Output      string // Where the rendered file is written in the Flutter project
+	Data        string // Name of the data type the template is rendered with
+	Description string
+}
+
+// Catalog lists every built-in template. The data types are documented in
+// data.go; every template can also use the pascal, camel, snake, kebab and
+// join functions.
+var Catalog = []Template{
+	// Project skeleton (fline create)
+	{"project/main.dart", "lib/main.dart", "ProjectData", "Application entry point"},
+	{"project/app.dart", "lib/app.dart", "ProjectData", "Root widget with router, theme and localizations"},
+	{"project/di/dependency_injector.dart", "lib/di/dependency_injector.dart", "ProjectData", "Pine dependency injector"},
+	{"project/di/blocs.dart", "lib/di/blocs.dart", "ProjectData", "BLoC registrations"},
+	{"project/di/mappers.dart", "lib/di/mappers.dart", "ProjectData", "Mapper registrations"},
+	{"project/di/providers.dart", "lib/di/providers.dart", "ProjectData", "Provider registrations (Logger, Dio, storage)"},
+	{"project/di/repositories.dart", "lib/di/repositories.dart", "ProjectData", "Repository registrations"},
+	{"project/theme/light_theme.dart", "lib/theme/light_theme.dart", "ProjectData", "Light theme"},
+	{"project/routers/app_router.dart", "lib/routers/app_router.dart", "ProjectData", "auto_route router configuration"},
+	{"project/l10n/app_en.arb", "lib/l10n/app_en.arb", "ProjectData", "English strings"},
+	{"project/l10n/app_it.arb", "lib/l10n/app_it.arb", "ProjectData", "Italian strings"},
+	{"project/l10n.yaml", "l10n.yaml", "ProjectData", "gen-l10n configuration"},
+	{"project/CLAUDE.md", "CLAUDE.md", "ProjectData", "Project guidelines for AI assistants"},
+	{"project/Makefile", "Makefile", "ProjectData", "Steps skipped by --skip-pub-get/--skip-codegen"},
+
+	// Example screens
+	{"screens/login_page.dart", "lib/ui/login/login_page.dart", "ProjectData", "Login screen"},
+	{"screens/login_form.dart", "lib/ui/login/widgets/login_form.dart", "ProjectData", "Login form"},
+	{"screens/sign_up_page.dart", "lib/ui/sign_up/sign_up_page.dart", "ProjectData", "Sign up screen"},
+	{"screens/sign_up_form.dart", "lib/ui/sign_up/widgets/sign_up_form.dart", "ProjectData", "Sign up form"},
+	{"screens/forgot_password_page.dart", "lib/ui/forgot_password/forgot_password_page.dart", "ProjectData", "Password reset screen"},
+	{"screens/forgot_password_form.dart", "lib/ui/forgot_password/widgets/forgot_password_form.dart", "ProjectData", "Password reset form"},
+	{"screens/widgets/auth_header.dart", "lib/ui/shared/widgets/auth_header.dart", "ProjectData", "Header of the authentication screens"},
+	{"screens/widgets/auth_submit_button.dart", "lib/ui/shared/widgets/auth_submit_button.dart", "ProjectData", "Submit button showing the AuthBloc progress"},
+	{"screens/widgets/email_field.dart", "lib/ui/shared/widgets/email_field.dart", "ProjectData", "Validated email field"},
+	{"screens/widgets/password_field.dart", "lib/ui/shared/widgets/password_field.dart", "ProjectData", "Validated password field"},
+	{"screens/widgets/social_sign_in_buttons.dart", "lib/ui/shared/widgets/social_sign_in_buttons.dart", "ProjectData", "Social sign-in buttons of the login screen"},
+	{"screens/home_page.dart", "lib/ui/home/home_page.dart", "ProjectData", "Home screen"},
+	{"screens/home_drawer.dart", "lib/ui/home/widgets/home_drawer.dart", "ProjectData", "Navigation drawer of the home screen"},
+	{"screens/welcome_card.dart", "lib/ui/home/widgets/welcome_card.dart", "ProjectData", "Welcome card of the home screen"},
+	{"screens/quick_actions.dart", "lib/ui/home/widgets/quick_actions.dart", "ProjectData", "Quick actions of the home screen"},
+	{"screens/action_card.dart", "lib/ui/home/widgets/action_card.dart", "ProjectData", "Card of a quick action"},
+	{"screens/profile_cubit.dart", "lib/state_management/cubit/profile/profile_cubit.dart", "ProjectData", "Cubit of the profile details"},
+	{"screens/profile_state.dart", "lib/state_management/cubit/profile/profile_state.dart", "ProjectData", "State of the profile details"},
+	{"screens/profile_page.dart", "lib/ui/profile/profile_page.dart", "ProjectData", "Profile screen"},
+	{"screens/profile_header.dart", "lib/ui/profile/widgets/profile_header.dart", "ProjectData", "Avatar, name and email of the profile"},
+	{"screens/profile_details.dart", "lib/ui/profile/widgets/profile_details.dart", "ProjectData", "Editable profile details"},
+	{"screens/profile_detail_tile.dart", "lib/ui/profile/widgets/profile_detail_tile.dart", "ProjectData", "Tile of a profile detail"},
+	{"screens/edit_profile_field_dialog.dart", "lib/ui/profile/widgets/edit_profile_field_dialog.dart", "ProjectData", "Dialog editing a profile detail"},
+	{"screens/account_actions.dart", "lib/ui/profile/widgets/account_actions.dart", "ProjectData", "Account management links of the profile"},
+	{"screens/settings_cubit.dart", "lib/state_management/cubit/settings/settings_cubit.dart", "ProjectData", "Cubit of the preferences"},
+	{"screens/settings_state.dart", "lib/state_management/cubit/settings/settings_state.dart", "ProjectData", "State of the preferences"},
+	{"screens/settings_page.dart", "lib/ui/settings/settings_page.dart", "ProjectData", "Settings screen"},
+	{"screens/section_header.dart", "lib/ui/settings/widgets/section_header.dart", "ProjectData", "Header of a settings section"},
+	{"screens/general_settings.dart", "lib/ui/settings/widgets/general_settings.dart", "ProjectData", "Preference switches"},
+	{"screens/app_settings.dart", "lib/ui/settings/widgets/app_settings.dart", "ProjectData", "Language and cache settings"},
+	{"screens/about_settings.dart", "lib/ui/settings/widgets/about_settings.dart", "ProjectData", "Information about the app"},
+	{"screens/splash_cubit.dart", "lib/state_management/cubit/splash/splash_cubit.dart", "ProjectData", "Cubit choosing the screen the app starts on"},
+	{"screens/splash_page.dart", "lib/ui/splash/splash_page.dart", "ProjectData", "Splash screen restoring the session"},
+	{"screens/splash_view.dart", "lib/ui/splash/widgets/splash_view.dart", "ProjectData", "Logo and name shown by the splash screen"},
+	{"screens/onboarding_repository.dart", "lib/repositories/onboarding_repository.dart", "ProjectData", "Completion of the onboarding, in shared_preferences"},
+	{"screens/onboarding_cubit.dart", "lib/state_management/cubit/onboarding/onboarding_cubit.dart", "ProjectData", "Cubit of the onboarding carousel"},
+	{"screens/onboarding_state.dart", "lib/state_management/cubit/onboarding/onboarding_state.dart", "ProjectData", "State of the onboarding carousel"},
+	{"screens/onboarding_page.dart", "lib/ui/onboarding/onboarding_page.dart", "ProjectData", "Onboarding screen"},
+	{"screens/onboarding_carousel.dart", "lib/ui/onboarding/widgets/onboarding_carousel.dart", "ProjectData", "Slides of the onboarding"},
+	{"screens/onboarding_slide.dart", "lib/ui/onboarding/widgets/onboarding_slide.dart", "ProjectData", "Slide of the onboarding"},
+	{"screens/onboarding_controls.dart", "lib/ui/onboarding/widgets/onboarding_controls.dart", "ProjectData", "Skip and next buttons of the onboarding"},
+	{"screens/page_indicator.dart", "lib/ui/onboarding/widgets/page_indicator.dart", "ProjectData", "Dots marking the current slide"},
+	{"screens/app_shell_page.dart", "lib/ui/app_shell/app_shell_page.dart", "ProjectData", "Bottom navigation shell on AutoTabsRouter"},
+	{"screens/app_navigation_bar.dart", "lib/ui/app_shell/widgets/app_navigation_bar.dart", "ProjectData", "Navigation bar of the shell tabs"},
+	{"screens/widgets/empty_view.dart", "lib/ui/shared/widgets/empty_view.dart", "ProjectData", "Placeholder of an empty list"},
+	{"screens/widgets/error_view.dart", "lib/ui/shared/widgets/error_view.dart", "ProjectData", "Error message with a retry button"},
+
+	// Authentication flow of the login screens
+	{"auth/auth_repository.dart", "lib/repositories/auth_repository.dart", "ProjectData", "Auth repository over the backend auth service"},
+	{"auth/auth_bloc.dart", "lib/state_management/bloc/auth/auth_bloc.dart", "ProjectData", "Sign in, sign up, password reset and sign out BLoC"},
+	{"auth/auth_event.dart", "lib/state_management/bloc/auth/auth_event.dart", "ProjectData", "AuthBloc events"},
+	{"auth/auth_state.dart", "lib/state_management/bloc/auth/auth_state.dart", "ProjectData", "AuthBloc states"},
+	{"auth/validators.dart", "lib/utils/validators.dart", "ProjectData", "Localized form validators"},
+	{"auth/auth_error_messages.dart", "lib/utils/auth_error_messages.dart", "ProjectData", "Localized auth error messages"},
+	{"auth/auth_guard.dart", "lib/routers/auth_guard.dart", "ProjectData", "Route guard sending signed out users to login"},
+	{"auth/token_store.dart", "lib/repositories/token_store.dart", "ProjectData", "Session tokens in the secure storage"},
+	{"auth/social_sign_in.md", "SOCIAL_SIGN_IN.md", "ProjectData", "Platform setup checklist of the social providers"},
+
+	// Backend integrations
+	{"firebase/firebase_initializer.dart", "lib/utils/firebase_initializer.dart", "ProjectData", "Firebase initialization"},
+	{"firebase/firebase_options.dart", "lib/firebase_options.dart", "ProjectData", "Firebase options from the native configuration files"},
+	{"firebase/auth_service.dart", "lib/network/service/auth_service.dart", "ProjectData", "Firebase auth service"},
+	{"firebase/storage_service.dart", "lib/network/service/storage_service.dart", "ProjectData", "Firebase Storage uploader"},
+	{"firebase/firestore.rules", "firestore.rules", "ModelData", "Firestore security rules skeleton"},
+	{"firebase/firestore.indexes.json", "firestore.indexes.json", "ModelData", "Firestore indexes"},
+	{"firebase/firestore_tests_package.json", "firestore-tests/package.json", "ModelData", "Security rules test runner"},
+	{"firebase/analytics_service.dart", "lib/network/service/analytics_service.dart", "ProjectData", "Firebase Analytics wrapper"},
+	{"supabase/supabase_client.dart", "lib/utils/supabase_client.dart", "ProjectData", "Supabase client configuration"},
+	{"supabase/auth_service.dart", "lib/network/service/supabase_auth_service.dart", "ProjectData", "Supabase auth service"},
+	{"supabase/database_service.dart", "lib/network/service/supabase_database_service.dart", "ProjectData", "Supabase table CRUD and realtime"},
+	{"supabase/storage_service.dart", "lib/network/service/supabase_storage_service.dart", "ProjectData", "Supabase Storage uploader"},
+	{"supabase/env", "env/<environment>.env", "ProjectData", "Supabase credentials for --dart-define-from-file"},
+	{"supabase/launch.json", ".vscode/launch.json", "ProjectData", "VS Code launch configurations per environment"},
+	{"rest/api_config.dart", "lib/utils/api_config.dart", "ProjectData", "Base URL of the REST API"},
+	{"rest/auth_tokens.dart", "lib/model/auth_tokens.dart", "ProjectData", "JWT tokens and requests of the REST auth endpoints"},
+	{"rest/auth_service.dart", "lib/network/service/auth_service.dart", "ProjectData", "Retrofit service of the REST auth endpoints"},
+	{"rest/refresh_token_interceptor.dart", "lib/network/interceptor/refresh_token_interceptor.dart", "ProjectData", "Dio interceptor sending and renewing the access token"},
+
+	// Notifications
+	{"notifications/notification_service.dart", "lib/network/service/notification_service.dart", "ProjectData", "NotificationService abstraction"},
+	{"notifications/fcm_notification_service.dart", "lib/network/service/fcm_notification_service.dart", "ProjectData", "Firebase Cloud Messaging provider and background handler"},
+	{"notifications/onesignal_notification_service.dart", "lib/network/service/onesignal_notification_service.dart", "ProjectData", "OneSignal provider"},
+	{"notifications/local_notification_service.dart", "lib/network/service/local_notification_service.dart", "ProjectData", "Local notifications provider"},
+	{"notifications/notification_router.dart", "lib/routers/notification_router.dart", "ProjectData", "Opens the route of tapped notifications"},
+
+	// Models from JSON (fline model)
+	{"model/model.dart", "lib/model/<name>.dart", "ModelData", "json_serializable model"},
+	{"model/service.dart", "lib/network/service/<name>_service.dart", "ModelData", "Retrofit service"},
+	{"model/repository.dart", "lib/repositories/<name>_repository.dart", "ModelData", "Repository"},
+	{"model/firestore_service.dart", "lib/network/service/<name>_firestore_service.dart", "ModelData", "Firestore collection data source"},
+	{"model/firestore_repository.dart", "lib/repositories/<name>_repository.dart", "ModelData", "Repository over a Firestore collection"},
+	{"model/firestore_rules", "firestore.rules (block)", "ModelData", "Security rules of a Firestore collection"},
+	{"model/firestore_rules_test.js", "firestore-tests/<collection>.test.js", "ModelData", "Emulator tests of the collection rules"},
+	{"model/supabase_service.dart", "lib/network/service/<name>_supabase_service.dart", "ModelData", "Supabase table data source"},
+	{"model/supabase_repository.dart", "lib/repositories/<name>_repository.dart", "ModelData", "Repository over a Supabase table"},
+	{"model/supabase_migration.sql", "supabase/migrations/<timestamp>_<table>.sql", "ModelData", "Supabase table, RLS policies and realtime"},
+	{"model/bloc.dart", "lib/state_management/bloc/<name>/<name>_bloc.dart", "ModelData", "CRUD BLoC, watching realtime sources"},
+	{"model/event.dart", "lib/state_management/bloc/<name>/<name>_event.dart", "ModelData", "BLoC events"},
+	{"model/state.dart", "lib/state_management/bloc/<name>/<name>_state.dart", "ModelData", "BLoC states"},
+
+	// Screens of a model (fline generate screen)
+	{"model_screens/list_page.dart", "lib/ui/<name>_list/<name>_list_page.dart", "ScreenData", "Paginated list screen"},
+	{"model_screens/list.dart", "lib/ui/<name>_list/widgets/<name>_list.dart", "ScreenData", "Items of the BLoC, pulled to refresh"},
+	{"model_screens/list_tile.dart", "lib/ui/<name>_list/widgets/<name>_list_tile.dart", "ScreenData", "Item of the list, opening its detail"},
+	{"model_screens/detail_page.dart", "lib/ui/<name>_detail/<name>_detail_page.dart", "ScreenData", "Detail screen with edit and delete"},
+	{"model_screens/details.dart", "lib/ui/<name>_detail/widgets/<name>_details.dart", "ScreenData", "Fields of an item"},
+	{"model_screens/form_page.dart", "lib/ui/<name>_form/<name>_form_page.dart", "ScreenData", "Create and edit screen"},
+	{"model_screens/form.dart", "lib/ui/<name>_form/widgets/<name>_form.dart", "ScreenData", "Form with a field per editable model field"},
+	{"model_screens/text_input_field.dart", "lib/ui/shared/widgets/text_input_field.dart", "ScreenData", "Required text field"},
+	{"model_screens/number_input_field.dart", "lib/ui/shared/widgets/number_input_field.dart", "ScreenData", "Required number field"},
+	{"model_screens/switch_field.dart", "lib/ui/shared/widgets/switch_field.dart", "ScreenData", "On/off form field"},
+
+	// Features without a model (fline generate)
+	{"feature/service.dart", "lib/network/service/<name>_service.dart", "FeatureData", "Untyped Retrofit service"},
+	{"feature/repository.dart", "lib/repositories/<name>_repository.dart", "FeatureData", "Untyped repository"},
+}
+
+// Lookup returns the catalog entry for a template name
+func Lookup(name string) (Template, bool) {
+	for _, t := range Catalog {
+		if t.Name == name {
+			return t, true
+		}
+	}
+	return Template{}, false
+}
@@ -0,0 +1,40 @@
+package templates
+
+import (
+	"io/fs"
+	"strings"
+	"testing"
+)
+
+func TestCatalogRenders(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	loader := NewLoader(t.TempDir())
+
+	for _, tmpl := range Catalog {
+		t.Run(tmpl.Name, func(t *testing.T) {
+			out, err := loader.Render(tmpl.Name, tmpl.SampleData())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if strings.TrimSpace(out) == "" {
+				t.Error("rendered output is empty")
+			}
+		})
+	}
+}
+
+func TestCatalogCoversBuiltins(t *testing.T) {
+	err := fs.WalkDir(builtinFS, "builtin", func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() || !strings.HasSuffix(path, ".tmpl") {
+			return err
+		}
+		name := strings.TrimSuffix(strings.TrimPrefix(path, "builtin/"), ".tmpl")
+		if _, ok := Lookup(name); !ok {
+			t.Errorf("built-in template %s is missing from the catalog", name)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,265 @@
+package templates
+
+import (
+	"strings"
+
+	"fline-cli/internal/config"
+	"fline-cli/internal/utils"
+)
+
+// Names holds the case variants of an identifier, e.g. for "user_profile":
+// Pascal "UserProfile", Camel "userProfile", Snake "user_profile",
+// Kebab "user-profile", ScreamingSnake "USER_PROFILE"
+type Names struct {
+	Original       string
+	Pascal         string
+	Camel          string
+	Snake          string
+	Kebab          string
+	ScreamingSnake string
+}
+
+// NewNames builds the case variants of name
+func NewNames(name string) Names {
+	naming := utils.NewNamingHelper(name)
+	return Names{
+		Original:       naming.Original(),
+		Pascal:         naming.PascalCase(),
+		Camel:          naming.CamelCase(),
+		Snake:          naming.SnakeCase(),
+		Kebab:          naming.KebabCase(),
+		ScreamingSnake: naming.ScreamingSnakeCase(),
+	}
+}
+
+// Dependency is a pubspec dependency. SDK dependencies (e.g. flutter) set
+// SDK and leave Version empty.
+type Dependency struct {
+	Name    string
+	Version string
+	SDK     string
+}
+
+// ProjectData is the data passed to project/*, screens/*, firebase/* and
+// supabase/* templates
+type ProjectData struct {
+	ProjectName         string // Dart package name, e.g. my_app
+	Organization        string // e.g. com.example
+	Description         string
+	UseFirebase         bool
+	UseSupabase         bool
+	UseRest             bool
+	Firebase            config.FirebaseConfig // Selected Firebase modules
+	Rest                config.RestConfig     // Base URL and auth endpoints of the REST API
+	EnableNotifications bool
+	NotificationService string // "fcm", "onesignal" or "local"
+	AuthBackend         string // "firebase", "supabase", "rest" or empty, see config.AuthBackend
+	AppContext          string // Free-form app description for CLAUDE.md
+	SupabaseURL         string // Written to the env files, never to Dart code
+	SupabaseAnonKey     string
+	Dependencies        []Dependency
+	DevDependencies     []Dependency
+	PendingSteps        []SetupStep       // Toolchain steps skipped during create
+	Strings             []LocalizedString // Strings of the generated screens
+	SocialProviders     []SocialProvider  // Social sign-in of the login screen
+	AuthRedirectScheme  string            // Scheme of the deep link OAuth sign-in returns to
+
+	// Example screens to generate
+	GenerateLoginScreen    bool // Also the sign up and password reset screens
+	GenerateHomeScreen     bool
+	GenerateProfileScreen  bool
+	GenerateSettingsScreen bool
+
+	// App shell screens
+	GenerateSplashScreen     bool
+	GenerateOnboardingScreen bool
+	GenerateShellScreen      bool   // AppShellPage, tabs of the home, profile and settings screens
+	GenerateStateViews       bool   // Shared EmptyView and ErrorView
+	MainRoute                string // Route opened once signed in: AppShellRoute or HomeRoute
+
+	// Firebase options read from google-services.json and
+	// GoogleService-Info.plist, nil when the file is not available
+	FirebaseAndroid *FirebasePlatformOptions
+	FirebaseIOS     *FirebasePlatformOptions
+}
+
+// SocialProvider is a social sign-in provider of the login screen
+type SocialProvider struct {
+	ID   string // Provider name, also the SocialProvider Dart enum value
+	Name string // Display name, e.g. GitHub
+	Icon string // FontAwesomeIcons member of the button
+}
+
+// socialProviders describes the providers of config.SocialProviders
+var socialProviders = map[string]SocialProvider{
+	config.SocialGoogle: {ID: "google", Name: "Google", Icon: "google"},
+	config.SocialApple:  {ID: "apple", Name: "Apple", Icon: "apple"},
+	config.SocialGitHub: {ID: "github", Name: "GitHub", Icon: "github"},
+}
+
+// SocialProviders returns the social providers cfg selects, in display
+// order
+func SocialProviders(cfg *config.ProjectConfig) []SocialProvider {
+	var providers []SocialProvider
+	for _, id := range config.SocialProviders {
+		if cfg.HasSocialSignIn(id) {
+			providers = append(providers, socialProviders[id])
+		}
+	}
+	return providers
+}
+
+// AuthRedirectScheme returns the scheme of the deep link the OAuth page of
+// the auth backend redirects to, e.g. com.example.myapp for
+// com.example.myapp://login-callback. URL schemes can't contain
+// underscores.
+func AuthRedirectScheme(cfg *config.ProjectConfig) string {
+	return strings.ToLower(cfg.OrganizationName + "." + strings.ReplaceAll(cfg.ProjectName, "_", ""))
+}
+
+// FirebasePlatformOptions are the FirebaseOptions of one platform
+type FirebasePlatformOptions struct {
+	APIKey            string
+	AppID             string
+	MessagingSenderID string
+	ProjectID         string
+	StorageBucket     string
+	IOSClientID       string // iOS only
+	IOSBundleID       string // iOS only
+}
+
+// NewProjectData builds the project template data from a config
+func NewProjectData(cfg *config.ProjectConfig) ProjectData {
+	description := cfg.Description
+	if description == "" {
+		description = "A new Flutter project with Pine architecture."
+	}
+
+	deps, devDeps := PubspecDependencies(cfg)
+
+	return ProjectData{
+		ProjectName:         cfg.ProjectName,
+		Organization:        cfg.OrganizationName,
+		Description:         description,
+		UseFirebase:         cfg.UseFirebase,
+		UseSupabase:         cfg.UseSupabase,
+		UseRest:             cfg.UseRest,
+		Firebase:            cfg.Firebase,
+		Rest:                cfg.Rest,
+		EnableNotifications: cfg.EnableNotifications,
+		NotificationService: cfg.NotificationService,
+		AuthBackend:         cfg.AuthBackend(),
+		AppContext:          cfg.AppContext,
+		SupabaseURL:         cfg.Supabase.ProjectURL,
+		SupabaseAnonKey:     cfg.Supabase.AnonKey,
+		Dependencies:        deps,
+		DevDependencies:     devDeps,
+		PendingSteps:        PendingSetupSteps(cfg),
+		Strings:             ScreenStrings(cfg),
+		SocialProviders:     SocialProviders(cfg),
+		AuthRedirectScheme:  AuthRedirectScheme(cfg),
+
+		GenerateLoginScreen:    cfg.GenerateLoginScreen,
+		GenerateHomeScreen:     cfg.GenerateHomeScreen,
+		GenerateProfileScreen:  cfg.GenerateProfileScreen,
+		GenerateSettingsScreen: cfg.GenerateSettingsScreen,
+
+		GenerateSplashScreen:     cfg.GenerateSplashScreen,
+		GenerateOnboardingScreen: cfg.GenerateOnboardingScreen,
+		GenerateShellScreen:      cfg.GenerateShellScreen,
+		GenerateStateViews:       cfg.GenerateStateViews,
+		MainRoute:                MainRoute(cfg),
+	}
+}
+
+// MainRoute returns the route the app opens once signed in: the bottom
+// navigation shell, on the home tab, or the home screen
+func MainRoute(cfg *config.ProjectConfig) string {
+	if cfg.GenerateShellScreen {
+		return "AppShellRoute"
+	}
+	return "HomeRoute"
+}
+
+// Field is a model field inferred from JSON
+type Field struct {
+	Name string // JSON key, used as the Dart field name
+	Type string // Dart type, e.g. int, String, List<String>
+}
+
+// ModelData is the data passed to model/* templates
+type ModelData struct {
+	PackageName string // Dart package name used in imports
+	Name        Names  // Model name
+	Endpoint    string // REST endpoint, e.g. /api/users
+	Collection  string // Firestore collection or Supabase table, e.g. users
+	Realtime    bool   // The data source can stream changes
+	OwnerField  string // Field holding the owner user ID, if any
+	Fields      []Field
+
+	// SQL column definitions of the table storing the model and the owner
+	// column, quoted when needed (Supabase)
+	Columns     []string
+	OwnerColumn string
+
+	// Firestore security rules type checks of a document, e.g.
+	// "data.done is bool", and the properties of a valid document in the
+	// JavaScript rules tests, e.g. "done: true" (Firestore)
+	RulesChecks  []string
+	TestDocument []string
+}
+
+// HasField reports whether the model has a field named name
+func (d ModelData) HasField(name string) bool {
+	for _, f := range d.Fields {
+		if f.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// DocumentFields returns the fields stored in a Firestore document. The id
+// field is the document ID, not stored in the document.
+func (d ModelData) DocumentFields() []Field {
+	var fields []Field
+	for _, f := range d.Fields {
+		if f.Name != "id" {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// ScreenField is a model field as the screens of fline generate screen show
+// and edit it. Dart expressions refer to the shown model as item and to the
+// edited one as edited.
+type ScreenField struct {
+	Name    string // Dart field name
+	Label   string // English label, e.g. First name
+	Key     string // Suffix of the label string key, e.g. FirstName
+	Input   string // Form field: text, int, double or bool; empty when not editable
+	Display string // Dart String showing the value, e.g. item.age.toString()
+	Value   string // Dart value of the saved model, e.g. edited?.tags ?? const []
+}
+
+// ScreenData is the data passed to model_screens/* templates
+type ScreenData struct {
+	PackageName string // Dart package name used in imports
+	Name        Names  // Model name
+	Label       string // English name of the model, e.g. User profile
+	Fields      []ScreenField
+	Title       string // Dart String naming item in the list
+	ID          string // Appended to a model to read its ID as a String, e.g. .id.toString()
+
+	// Screens of the model, linked to each other when generated
+	List   bool
+	Detail bool
+	Form   bool
+}
+
+// FeatureData is the data passed to feature/* templates
+type FeatureData struct {
+	PackageName string // Dart package name used in imports
+	Name        Names  // Feature name
+}
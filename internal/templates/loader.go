@@ -0,0 +1,231 @@
+package templates
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"fline-cli/internal/utils"
+)
+
+//go:embed builtin
+var builtinFS embed.FS
+
+const (
+	// ProjectTemplatesDir is where project-level overrides live, relative to the project root
+	ProjectTemplatesDir = ".fline/templates"
+
+	// TemplateExt is the extension of every template file
+	TemplateExt = ".tmpl"
+)
+
+// SourceKind tells where a template was resolved from
+type SourceKind string
+
+const (
+	SourceProject SourceKind = "project"
+	SourceUser    SourceKind = "user"
+	SourceBuiltin SourceKind = "built-in"
+)
+
+// Source describes the file a template was resolved to
+type Source struct {
+	Kind SourceKind
+	Path string // Absolute path for overrides, embedded path for built-ins
+}
+
+// Loader resolves templates by name, looking for overrides before
+// falling back to the built-in templates:
+//
+//  1. <project>/.fline/templates/<name>.tmpl
+//  2. ~/.config/fline/templates/<name>.tmpl
+//  3. built-in
+type Loader struct {
+	projectDir string
+}
+
+// NewLoader creates a loader for the project rooted at projectRoot
+func NewLoader(projectRoot string) *Loader {
+	return &Loader{
+		projectDir: filepath.Join(projectRoot, ProjectTemplatesDir),
+	}
+}
+
+// UserTemplatesDir returns the user-level override directory
+func UserTemplatesDir() string {
+	configDir := os.Getenv("XDG_CONFIG_HOME")
+	if configDir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+		configDir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(configDir, "fline", "templates")
+}
+
+// ProjectDir returns the project-level override directory
+func (l *Loader) ProjectDir() string {
+	return l.projectDir
+}
+
+type overrideDir struct {
+	kind SourceKind
+	dir  string
+}
+
+// overrideDirs returns the override directories in lookup order
+func (l *Loader) overrideDirs() []overrideDir {
+	return []overrideDir{
+		{SourceProject, l.projectDir},
+		{SourceUser, UserTemplatesDir()},
+	}
+}
+
+// Override is a template file found in one of the override directories
+type Override struct {
+	Name   string
+	Source Source
+}
+
+// Overrides lists the template files in the project and user override
+// directories, including files that do not match any built-in template
+func (l *Loader) Overrides() ([]Override, error) {
+	var overrides []Override
+
+	for _, d := range l.overrideDirs() {
+		if d.dir == "" {
+			continue
+		}
+		err := filepath.WalkDir(d.dir, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				if os.IsNotExist(err) {
+					return filepath.SkipDir
+				}
+				return err
+			}
+			if entry.IsDir() || !strings.HasSuffix(path, TemplateExt) {
+				return nil
+			}
+			rel, err := filepath.Rel(d.dir, path)
+			if err != nil {
+				return err
+			}
+			abs, err := filepath.Abs(path)
+			if err != nil {
+				abs = path
+			}
+			overrides = append(overrides, Override{
+				Name:   strings.TrimSuffix(filepath.ToSlash(rel), TemplateExt),
+				Source: Source{Kind: d.kind, Path: abs},
+			})
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan %s: %w", d.dir, err)
+		}
+	}
+
+	return overrides, nil
+}
+
+// Resolve finds the file a template name maps to
+func (l *Loader) Resolve(name string) (Source, error) {
+	file := filepath.FromSlash(name) + TemplateExt
+
+	for _, o := range l.overrideDirs() {
+		if o.dir == "" {
+			continue
+		}
+		path := filepath.Join(o.dir, file)
+		if _, err := os.Stat(path); err == nil {
+			abs, err := filepath.Abs(path)
+			if err != nil {
+				abs = path
+			}
+			return Source{Kind: o.kind, Path: abs}, nil
+		}
+	}
+
+	path := "builtin/" + name + TemplateExt
+	if _, err := fs.Stat(builtinFS, path); err != nil {
+		return Source{}, fmt.Errorf("unknown template %q", name)
+	}
+
+	return Source{Kind: SourceBuiltin, Path: path}, nil
+}
+
+// Builtin returns the raw content of a built-in template
+func Builtin(name string) (string, error) {
+	content, err := builtinFS.ReadFile("builtin/" + name + TemplateExt)
+	if err != nil {
+		return "", fmt.Errorf("unknown template %q", name)
+	}
+	return string(content), nil
+}
+
+// Load reads and parses a template, honoring overrides
+func (l *Loader) Load(name string) (*template.Template, Source, error) {
+	src, err := l.Resolve(name)
+	if err != nil {
+		return nil, src, err
+	}
+
+	var content []byte
+	if src.Kind == SourceBuiltin {
+		content, err = builtinFS.ReadFile(src.Path)
+	} else {
+		content, err = os.ReadFile(src.Path)
+	}
+	if err != nil {
+		return nil, src, fmt.Errorf("failed to read template %s: %w", src.Path, err)
+	}
+
+	tmpl, err := Parse(name, string(content))
+	if err != nil {
+		return nil, src, err
+	}
+
+	return tmpl, src, nil
+}
+
+// Render renders the named template with data
+func (l *Loader) Render(name string, data interface{}) (string, error) {
+	tmpl, src, err := l.Load(name)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render template %s (%s): %w", name, src.Kind, err)
+	}
+
+	return buf.String(), nil
+}
+
+// Parse parses template content with the functions available to every template
+func Parse(name, content string) (*template.Template, error) {
+	tmpl, err := template.New(name).
+		Option("missingkey=error").
+		Funcs(funcs).
+		Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template %s: %w", name, err)
+	}
+	return tmpl, nil
+}
+
+// funcs are the helpers available inside templates
+var funcs = template.FuncMap{
+	"pascal": func(s string) string { return utils.NewNamingHelper(s).PascalCase() },
+	"camel":  func(s string) string { return utils.NewNamingHelper(s).CamelCase() },
+	"snake":  func(s string) string { return utils.NewNamingHelper(s).SnakeCase() },
+	"kebab":  func(s string) string { return utils.NewNamingHelper(s).KebabCase() },
+	"join":   strings.Join,
+}
@@ -0,0 +1,103 @@
+package templates
+
+import (
+	"fline-cli/internal/config"
+)
+
+// SampleData returns representative data for a template, used to validate
+// templates without generating a project
+func (t Template) SampleData() interface{} {
+	switch t.Data {
+	case "ModelData":
+		return sampleModelData()
+	case "ScreenData":
+		return sampleScreenData()
+	case "FeatureData":
+		return FeatureData{
+			PackageName: "sample_app",
+			Name:        NewNames("order_item"),
+		}
+	default:
+		return sampleProjectData()
+	}
+}
+
+func sampleProjectData() ProjectData {
+	cfg := config.DefaultProjectConfig()
+	cfg.ProjectName = "sample_app"
+	cfg.Description = "A sample application"
+	cfg.UseFirebase = true
+	cfg.EnableNotifications = true
+	cfg.NotificationService = config.NotificationsFCM
+	cfg.Firebase.EnableStorage = true
+	cfg.Firebase.EnableAnalytics = true
+	cfg.Firebase.EnableCrashlytics = true
+	cfg.SocialSignIn = config.SocialProviders
+	cfg.GenerateProfileScreen = true
+	cfg.GenerateSettingsScreen = true
+	cfg.GenerateOnboardingScreen = true
+	cfg.GenerateShellScreen = true
+	cfg.GenerateStateViews = true
+	cfg.Normalize()
+	cfg.AppContext = "Sample app: Login → Home → Profile"
+	cfg.SkipPubGet = true
+
+	// Android configured, iOS left to flutterfire configure
+	data := NewProjectData(cfg)
+	data.FirebaseAndroid = &FirebasePlatformOptions{
+		APIKey:            "sample-api-key",
+		AppID:             "1:1234567890:android:abcdef",
+		MessagingSenderID: "1234567890",
+		ProjectID:         "sample-app",
+		StorageBucket:     "sample-app.appspot.com",
+	}
+	return data
+}
+
+func sampleModelData() ModelData {
+	return ModelData{
+		PackageName: "sample_app",
+		Name:        NewNames("user_profile"),
+		Endpoint:    "/api/user-profiles",
+		Collection:  "user_profiles",
+		Realtime:    true,
+		Fields: []Field{
+			{Name: "active", Type: "bool"},
+			{Name: "email", Type: "String"},
+			{Name: "id", Type: "int"},
+			{Name: "ownerId", Type: "String"},
+			{Name: "tags", Type: "List<String>"},
+		},
+		OwnerField:  "ownerId",
+		OwnerColumn: `"ownerId"`,
+		Columns: []string{
+			"id bigint generated by default as identity primary key",
+			"active boolean not null",
+			"email text not null",
+			`"ownerId" uuid not null default auth.uid() references auth.users (id) on delete cascade`,
+			"tags text[] not null",
+		},
+		RulesChecks:  []string{"data.active is bool", "data.email is string", "data.id is int", "data.ownerId is string", "data.tags is list"},
+		TestDocument: []string{"active: true", "email: 'text'", "id: 1", "ownerId: 'alice'", "tags: []"},
+	}
+}
+
+func sampleScreenData() ScreenData {
+	return ScreenData{
+		PackageName: "sample_app",
+		Name:        NewNames("user_profile"),
+		Label:       "User profile",
+		Fields: []ScreenField{
+			{Name: "active", Label: "Active", Key: "Active", Input: "bool", Display: "item.active ? l10n.yesLabel : l10n.noLabel", Value: "_activeKey.currentState?.value ?? false"},
+			{Name: "email", Label: "Email", Key: "Email", Input: "text", Display: "item.email", Value: "_emailController.text.trim()"},
+			{Name: "id", Label: "Id", Key: "Id", Display: "item.id.toString()", Value: "edited?.id ?? 0"},
+			{Name: "rating", Label: "Rating", Key: "Rating", Input: "double", Display: "item.rating.toString()", Value: "double.parse(_ratingController.text.trim())"},
+			{Name: "tags", Label: "Tags", Key: "Tags", Display: "item.tags.join(', ')", Value: "edited?.tags ?? const []"},
+		},
+		Title:  "item.email",
+		ID:     ".id.toString()",
+		List:   true,
+		Detail: true,
+		Form:   true,
+	}
+}
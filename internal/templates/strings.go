@@ -0,0 +1,223 @@
+package templates
+
+import (
+	"strings"
+
+	"fline-cli/internal/config"
+)
+
+// LocalizedString is an entry of the ARB files. Texts end up in JSON
+// strings, so they must not contain double quotes or backslashes.
+type LocalizedString struct {
+	Key         string
+	English     string
+	Italian     string
+	Description string // Meaning of the string for translators
+}
+
+// authStrings are the strings of the login, sign up and password reset
+// screens
+var authStrings = []LocalizedString{
+	{"loginTitle", "Welcome back", "Bentornato", "Title of the login screen"},
+	{"loginSubtitle", "Sign in to continue", "Accedi per continuare", "Subtitle of the login screen"},
+	{"signUpTitle", "Create an account", "Crea un account", "Title of the sign up screen"},
+	{"signUpSubtitle", "Sign up to get started", "Registrati per iniziare", "Subtitle of the sign up screen"},
+	{"forgotPasswordTitle", "Reset your password", "Reimposta la password", "Title of the password reset screen"},
+	{"forgotPasswordSubtitle", "Enter your email and we will send you a reset link", "Inserisci la tua email e ti invieremo un link per reimpostarla", "Subtitle of the password reset screen"},
+	{"emailLabel", "Email", "Email", "Label of the email field"},
+	{"passwordLabel", "Password", "Password", "Label of the password field"},
+	{"confirmPasswordLabel", "Confirm password", "Conferma password", "Label of the password confirmation field"},
+	{"signInButton", "Sign in", "Accedi", "Label of the sign in button"},
+	{"signUpButton", "Sign up", "Registrati", "Label of the sign up button"},
+	{"signOutButton", "Sign out", "Esci", "Label of the sign out button"},
+	{"sendResetLinkButton", "Send reset link", "Invia link", "Label of the password reset button"},
+	{"forgotPasswordButton", "Forgot password?", "Password dimenticata?", "Link to the password reset screen"},
+	{"noAccountPrompt", "Don't have an account?", "Non hai un account?", "Shown before the link to the sign up screen"},
+	{"haveAccountPrompt", "Already have an account?", "Hai già un account?", "Shown before the link to the login screen"},
+	{"passwordResetSent", "Check your inbox for the reset link", "Controlla la posta per il link di reimpostazione", "Shown once the password reset email is sent"},
+	{"signUpConfirmationSent", "Check your inbox to confirm your email", "Controlla la posta per confermare la tua email", "Shown when the sign up must be confirmed by email"},
+	{"emailRequired", "Please enter your email", "Inserisci la tua email", "Validation error of an empty email"},
+	{"emailInvalid", "Please enter a valid email", "Inserisci un'email valida", "Validation error of a malformed email"},
+	{"passwordRequired", "Please enter your password", "Inserisci la password", "Validation error of an empty password"},
+	{"passwordTooShort", "Password must be at least 6 characters", "La password deve avere almeno 6 caratteri", "Validation error of a short password"},
+	{"passwordsDoNotMatch", "Passwords do not match", "Le password non coincidono", "Validation error of a different confirmation"},
+	{"authErrorInvalidCredentials", "Wrong email or password", "Email o password errate", "Sign in error"},
+	{"authErrorEmailInUse", "An account with this email already exists", "Esiste già un account con questa email", "Sign up error"},
+	{"authErrorWeakPassword", "Choose a stronger password", "Scegli una password più sicura", "Sign up error"},
+	{"authErrorInvalidEmail", "This email address is not valid", "Questo indirizzo email non è valido", "Authentication error"},
+	{"authErrorTooManyRequests", "Too many attempts, try again later", "Troppi tentativi, riprova più tardi", "Authentication error"},
+	{"authErrorNetwork", "Check your connection and try again", "Controlla la connessione e riprova", "Authentication error"},
+	{"authErrorUnknown", "Something went wrong, please try again", "Qualcosa è andato storto, riprova", "Authentication error"},
+}
+
+// socialStrings are the strings of the social sign-in buttons, besides
+// their labels
+var socialStrings = []LocalizedString{
+	{"signInDivider", "or", "oppure", "Separates the social sign-in buttons from the form"},
+	{"authErrorCancelled", "Sign in cancelled", "Accesso annullato", "Shown when the user closes the social sign-in"},
+}
+
+// homeStrings are the strings of the home screen
+var homeStrings = []LocalizedString{
+	{"homeTitle", "Home", "Home", "Title of the home screen"},
+	{"homeWelcomeTitle", "Welcome!", "Benvenuto!", "Title of the welcome card on the home screen"},
+	{"homeWelcomeMessage", "This is your home screen. Start building your app!", "Questa è la tua home. Inizia a costruire la tua app!", "Message of the welcome card on the home screen"},
+	{"quickActionsTitle", "Quick actions", "Azioni rapide", "Title of the quick actions on the home screen"},
+	{"actionCreate", "Create new", "Crea nuovo", "Quick action creating an item"},
+	{"actionViewAll", "View all", "Vedi tutto", "Quick action listing the items"},
+	{"actionSearch", "Search", "Cerca", "Quick action searching the items"},
+	{"actionFavorites", "Favorites", "Preferiti", "Quick action showing the favorite items"},
+}
+
+// profileStrings are the strings of the profile screen
+var profileStrings = []LocalizedString{
+	{"profileTitle", "Profile", "Profilo", "Title of the profile screen"},
+	{"profileEditName", "Edit name", "Modifica nome", "Tooltip of the button editing the name"},
+	{"profileNoName", "Add your name", "Aggiungi il tuo nome", "Shown in place of a missing name"},
+	{"profileName", "Name", "Nome", "Label of the name"},
+	{"profilePhone", "Phone", "Telefono", "Label of the phone number"},
+	{"profileLocation", "Location", "Località", "Label of the location"},
+	{"profileBirthday", "Birthday", "Data di nascita", "Label of the birthday"},
+	{"profileNotSet", "Not set", "Non impostato", "Shown in place of a missing profile detail"},
+	{"changePassword", "Change password", "Cambia password", "Link to the password change"},
+	{"privacySettings", "Privacy settings", "Impostazioni privacy", "Link to the privacy settings"},
+	{"deleteAccount", "Delete account", "Elimina account", "Link to the account deletion"},
+}
+
+// settingsStrings are the strings of the settings screen
+var settingsStrings = []LocalizedString{
+	{"settingsTitle", "Settings", "Impostazioni", "Title of the settings screen"},
+	{"settingsGeneral", "General", "Generali", "Header of the general settings"},
+	{"settingsNotifications", "Notifications", "Notifiche", "Label of the notifications switch"},
+	{"settingsNotificationsDescription", "Enable push notifications", "Attiva le notifiche push", "Description of the notifications switch"},
+	{"settingsDarkMode", "Dark mode", "Tema scuro", "Label of the dark mode switch"},
+	{"settingsDarkModeDescription", "Use the dark theme", "Usa il tema scuro", "Description of the dark mode switch"},
+	{"settingsAutoSync", "Auto sync", "Sincronizzazione automatica", "Label of the auto sync switch"},
+	{"settingsAutoSyncDescription", "Automatically sync your data", "Sincronizza i dati automaticamente", "Description of the auto sync switch"},
+	{"settingsApp", "App", "App", "Header of the app settings"},
+	{"settingsLanguage", "Language", "Lingua", "Label of the language setting"},
+	{"languageName", "English", "Italiano", "Name of the current language"},
+	{"settingsClearCache", "Clear cache", "Svuota cache", "Label of the clear cache action"},
+	{"settingsClearCacheDescription", "Free up storage space", "Libera spazio di archiviazione", "Description of the clear cache action"},
+	{"cacheCleared", "Cache cleared", "Cache svuotata", "Shown once the cache is cleared"},
+	{"settingsAbout", "About", "Informazioni", "Header of the information about the app"},
+	{"termsOfService", "Terms of service", "Termini di servizio", "Link to the terms of service"},
+	{"privacyPolicy", "Privacy policy", "Informativa sulla privacy", "Link to the privacy policy"},
+	{"helpAndSupport", "Help & support", "Aiuto e supporto", "Link to the help pages"},
+}
+
+// ScreenStrings returns the localized strings used by the screens cfg
+// generates, in ARB order
+func ScreenStrings(cfg *config.ProjectConfig) []LocalizedString {
+	var strings []LocalizedString
+	if cfg.GenerateLoginScreen {
+		strings = append(strings, authStrings...)
+	}
+	if providers := SocialProviders(cfg); len(providers) > 0 {
+		strings = append(strings, socialStrings...)
+		for _, p := range providers {
+			strings = append(strings, LocalizedString{
+				Key:         "continueWith" + p.Name,
+				English:     "Continue with " + p.Name,
+				Italian:     "Continua con " + p.Name,
+				Description: "Label of the " + p.Name + " sign-in button",
+			})
+		}
+	}
+	if cfg.GenerateHomeScreen {
+		strings = append(strings, homeStrings...)
+	}
+	if cfg.GenerateProfileScreen {
+		strings = append(strings, profileStrings...)
+	}
+	if cfg.GenerateSettingsScreen {
+		strings = append(strings, settingsStrings...)
+	}
+	if cfg.GenerateOnboardingScreen {
+		strings = append(strings, onboardingStrings...)
+	}
+	if cfg.GenerateStateViews {
+		strings = append(strings, stateViewStrings...)
+	}
+	return strings
+}
+
+// onboardingStrings are the strings of the onboarding carousel
+var onboardingStrings = []LocalizedString{
+	{"onboardingWelcomeTitle", "Welcome", "Benvenuto", "Title of the first onboarding slide"},
+	{"onboardingWelcomeBody", "Everything you need, in one app", "Tutto ciò che ti serve, in un'unica app", "Text of the first onboarding slide"},
+	{"onboardingSyncTitle", "Stay in sync", "Resta sincronizzato", "Title of the second onboarding slide"},
+	{"onboardingSyncBody", "Your data follows you on every device", "I tuoi dati ti seguono su ogni dispositivo", "Text of the second onboarding slide"},
+	{"onboardingStartTitle", "Ready to start?", "Pronto a iniziare?", "Title of the last onboarding slide"},
+	{"onboardingStartBody", "It only takes a few seconds", "Bastano pochi secondi", "Text of the last onboarding slide"},
+	{"onboardingSkipButton", "Skip", "Salta", "Label of the button skipping the onboarding"},
+	{"onboardingNextButton", "Next", "Avanti", "Label of the button opening the next onboarding slide"},
+	{"onboardingDoneButton", "Get started", "Inizia", "Label of the button closing the onboarding"},
+}
+
+// stateViewStrings are the strings of the shared EmptyView and ErrorView,
+// also shown by the list of every model
+var stateViewStrings = []LocalizedString{
+	{"emptyListMessage", "Nothing here yet", "Ancora nessun elemento", "Shown by a list without items"},
+	{"loadErrorMessage", "Something went wrong", "Qualcosa è andato storto", "Shown when the data can't be loaded"},
+	{"retryButton", "Retry", "Riprova", "Label of the button loading the data again"},
+}
+
+// modelDetailStrings and modelFormStrings are the strings shared by the
+// screens of every model
+var (
+	modelDetailStrings = []LocalizedString{
+		{"loadErrorMessage", "Something went wrong", "Qualcosa è andato storto", "Shown when the data can't be loaded"},
+		{"retryButton", "Retry", "Riprova", "Label of the button loading the data again"},
+		{"editButton", "Edit", "Modifica", "Tooltip of the edit button"},
+		{"deleteButton", "Delete", "Elimina", "Tooltip of the delete button"},
+		{"yesLabel", "Yes", "Sì", "A true value"},
+		{"noLabel", "No", "No", "A false value"},
+	}
+	modelFormStrings = []LocalizedString{
+		{"saveButton", "Save", "Salva", "Label of the save button"},
+		{"saveErrorMessage", "Could not save, please try again", "Impossibile salvare, riprova", "Shown when a form can't be saved"},
+		{"fieldRequired", "This field is required", "Campo obbligatorio", "Validation error of an empty field"},
+		{"numberInvalid", "Please enter a valid number", "Inserisci un numero valido", "Validation error of a malformed number"},
+	}
+)
+
+// ModelScreenStrings returns the localized strings used by the screens of
+// a model, without duplicates. The strings naming the model or its fields
+// can't be translated: their Italian text is empty, so that they fall back
+// to English.
+func ModelScreenStrings(data ScreenData) []LocalizedString {
+	camel := data.Name.Camel
+	lower := strings.ToLower(data.Label)
+	var list []LocalizedString
+	if data.List {
+		list = append(list, stateViewStrings...)
+		list = append(list, LocalizedString{camel + "ListTitle", data.Label + "s", "", "Title of the " + lower + " list"})
+	}
+	if data.Detail {
+		list = append(list, modelDetailStrings...)
+		list = append(list, LocalizedString{camel + "DetailTitle", data.Label, "", "Title of the " + lower + " detail"})
+	}
+	if data.Form {
+		list = append(list, modelFormStrings...)
+		list = append(list,
+			LocalizedString{camel + "CreateTitle", "New " + lower, "", "Title of the form creating a " + lower},
+			LocalizedString{camel + "EditTitle", "Edit " + lower, "", "Title of the form editing a " + lower},
+		)
+	}
+	if data.Detail || data.Form {
+		for _, f := range data.Fields {
+			list = append(list, LocalizedString{camel + f.Key + "Label", f.Label, "", "Label of the " + f.Name + " of a " + lower})
+		}
+	}
+
+	seen := map[string]bool{}
+	unique := list[:0]
+	for _, s := range list {
+		if !seen[s.Key] {
+			seen[s.Key] = true
+			unique = append(unique, s)
+		}
+	}
+	return unique
+}
@@ -3,14 +3,16 @@ package ui
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
 type Logger struct {
 	prefix string
+	start  time.Time
 }
 
 func NewLogger(prefix string) *Logger {
-	return &Logger{prefix: prefix}
+	return &Logger{prefix: prefix, start: time.Now()}
 }
 
 func (l *Logger) Title(msg string) {
@@ -38,9 +40,10 @@ func (l *Logger) Warning(msg string) {
 	fmt.Println(ErrorStyle.Render(IconWarning + " " + msg))
 }
 
+// Step prints a progress step with the time elapsed since the logger was created
 func (l *Logger) Step(step int, total int, msg string) {
 	stepStr := fmt.Sprintf("[%d/%d]", step, total)
-	fmt.Println(InfoStyle.Render(stepStr) + " " + msg)
+	fmt.Println(InfoStyle.Render(stepStr) + " " + msg + " " + MutedStyle.Render(formatElapsed(time.Since(l.start))))
 }
 
 func (l *Logger) Box(title string, items []string) {
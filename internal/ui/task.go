@@ -0,0 +1,207 @@
+package ui
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-isatty"
+)
+
+// taskTailLines is how many output lines the spinner view shows
+const taskTailLines = 6
+
+// TaskFunc is a long-running step. Everything it writes to out is shown
+// by the task view.
+type TaskFunc func(ctx context.Context, out io.Writer) error
+
+// RunTask runs fn behind a spinner showing the last lines of its output.
+// On success the view collapses to a single line with the elapsed time; on
+// failure the whole output is printed. Pressing Ctrl-C cancels the context
+// passed to fn. Without a terminal the output is only printed on failure.
+func RunTask(ctx context.Context, title string, fn TaskFunc) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	start := time.Now()
+	log := &taskLog{}
+
+	var err error
+	if isatty.IsTerminal(os.Stdout.Fd()) {
+		err = runTaskView(ctx, cancel, title, log, fn)
+	} else {
+		err = fn(ctx, log)
+	}
+
+	elapsed := formatElapsed(time.Since(start))
+	if err == nil {
+		fmt.Println("  " + SuccessStyle.Render(IconSuccess) + " " + title + " " + MutedStyle.Render(elapsed))
+		return nil
+	}
+
+	fmt.Println("  " + ErrorStyle.Render(IconError) + " " + title + " " + MutedStyle.Render(elapsed))
+	for _, line := range log.Lines() {
+		fmt.Println(MutedStyle.Render("  │ ") + line)
+	}
+	return err
+}
+
+func runTaskView(ctx context.Context, cancel context.CancelFunc, title string, log *taskLog, fn TaskFunc) error {
+	model := newTaskModel(title, cancel)
+	program := tea.NewProgram(model)
+	log.onLine = func(line string) { program.Send(taskLineMsg(line)) }
+
+	done := make(chan error, 1)
+	go func() {
+		err := fn(ctx, log)
+		log.Flush()
+		done <- err
+		program.Send(taskDoneMsg{})
+	}()
+
+	// If the view fails (e.g. no usable terminal) fn keeps running and its
+	// output is still collected by log
+	_, _ = program.Run()
+	return <-done
+}
+
+type taskLineMsg string
+
+type taskDoneMsg struct{}
+
+// taskModel is the bubbletea model of the spinner view
+type taskModel struct {
+	title     string
+	spinner   spinner.Model
+	tail      []string
+	start     time.Time
+	cancel    context.CancelFunc
+	cancelled bool
+	done      bool
+}
+
+func newTaskModel(title string, cancel context.CancelFunc) taskModel {
+	s := spinner.New(spinner.WithSpinner(spinner.Dot))
+	s.Style = lipgloss.NewStyle().Foreground(Primary)
+	return taskModel{title: title, spinner: s, start: time.Now(), cancel: cancel}
+}
+
+func (m taskModel) Init() tea.Cmd {
+	return m.spinner.Tick
+}
+
+func (m taskModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if msg.Type == tea.KeyCtrlC {
+			// Keep the view until the process group is gone
+			m.cancelled = true
+			m.cancel()
+		}
+		return m, nil
+	case taskLineMsg:
+		m.tail = append(m.tail, string(msg))
+		if len(m.tail) > taskTailLines {
+			m.tail = m.tail[len(m.tail)-taskTailLines:]
+		}
+		return m, nil
+	case taskDoneMsg:
+		m.done = true
+		return m, tea.Quit
+	}
+
+	var cmd tea.Cmd
+	m.spinner, cmd = m.spinner.Update(msg)
+	return m, cmd
+}
+
+func (m taskModel) View() string {
+	// The final state is printed by RunTask once the view is gone
+	if m.done {
+		return ""
+	}
+
+	status := formatElapsed(time.Since(m.start))
+	if m.cancelled {
+		status = "cancelling..."
+	}
+
+	var b strings.Builder
+	b.WriteString("  " + m.spinner.View() + " " + m.title + " " + MutedStyle.Render(status) + "\n")
+	for _, line := range m.tail {
+		b.WriteString(MutedStyle.Render("  │ "+line) + "\n")
+	}
+	return b.String()
+}
+
+// taskLog collects the output of a task line by line. Carriage returns
+// (progress bars) start a new line.
+type taskLog struct {
+	mu      sync.Mutex
+	partial []byte
+	lines   []string
+	onLine  func(line string)
+}
+
+func (l *taskLog) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.partial = append(l.partial, p...)
+	for {
+		i := bytes.IndexAny(l.partial, "\r\n")
+		if i < 0 {
+			break
+		}
+		l.addLine(string(l.partial[:i]))
+		l.partial = l.partial[i+1:]
+	}
+	return len(p), nil
+}
+
+// Flush records the last line when the output does not end with a newline
+func (l *taskLog) Flush() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if len(l.partial) > 0 {
+		l.addLine(string(l.partial))
+		l.partial = nil
+	}
+}
+
+func (l *taskLog) addLine(line string) {
+	line = strings.TrimRight(line, " \t")
+	if line == "" {
+		return
+	}
+	l.lines = append(l.lines, line)
+	if l.onLine != nil {
+		l.onLine(line)
+	}
+}
+
+// Lines returns every line written so far
+func (l *taskLog) Lines() []string {
+	l.Flush()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]string(nil), l.lines...)
+}
+
+// formatElapsed formats a duration for progress output, e.g. "(1m12s)"
+func formatElapsed(d time.Duration) string {
+	if d < time.Minute {
+		return fmt.Sprintf("(%.1fs)", d.Seconds())
+	}
+	return fmt.Sprintf("(%s)", d.Round(time.Second))
+}
@@ -2,13 +2,52 @@ package utils
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 )
 
+// ConflictPolicy decides what WriteFile does when the target file already
+// exists with different content
+type ConflictPolicy int
+
+const (
+	// ConflictOverwrite replaces the existing file
+	ConflictOverwrite ConflictPolicy = iota
+	// ConflictSkip keeps the existing file
+	ConflictSkip
+	// ConflictFail returns a *ConflictError
+	ConflictFail
+)
+
+// ParseConflictPolicy parses "overwrite", "skip" or "fail"
+func ParseConflictPolicy(s string) (ConflictPolicy, error) {
+	switch s {
+	case "overwrite":
+		return ConflictOverwrite, nil
+	case "skip":
+		return ConflictSkip, nil
+	case "fail":
+		return ConflictFail, nil
+	default:
+		return ConflictOverwrite, fmt.Errorf("invalid conflict policy %q (use overwrite, skip or fail)", s)
+	}
+}
+
+// ConflictError is returned when a file exists and the policy is ConflictFail
+type ConflictError struct {
+	Path string
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("file %s already exists", e.Path)
+}
+
 // FileWriter handles file operations
 type FileWriter struct {
-	baseDir string
+	baseDir  string
+	conflict ConflictPolicy
+	skipped  []string
 }
 
 // NewFileWriter creates a new file writer
@@ -16,10 +55,29 @@ func NewFileWriter(baseDir string) *FileWriter {
 	return &FileWriter{baseDir: baseDir}
 }
 
+// SetConflictPolicy sets how existing files are handled (default: overwrite)
+func (fw *FileWriter) SetConflictPolicy(policy ConflictPolicy) {
+	fw.conflict = policy
+}
+
+// Skipped returns the files that were left untouched because of ConflictSkip
+func (fw *FileWriter) Skipped() []string {
+	return fw.skipped
+}
+
 // WriteFile writes content to a file, creating directories as needed
 func (fw *FileWriter) WriteFile(relativePath string, content string) error {
 	fullPath := filepath.Join(fw.baseDir, relativePath)
 
+	// Handle existing files according to the conflict policy
+	if fw.conflict != ConflictOverwrite && fw.HasConflict(relativePath, content) {
+		if fw.conflict == ConflictFail {
+			return &ConflictError{Path: relativePath}
+		}
+		fw.skipped = append(fw.skipped, relativePath)
+		return nil
+	}
+
 	// Create directory if it doesn't exist
 	dir := filepath.Dir(fullPath)
 	if err := os.MkdirAll(dir, 0755); err != nil {
@@ -34,6 +92,12 @@ func (fw *FileWriter) WriteFile(relativePath string, content string) error {
 	return nil
 }
 
+// HasConflict reports whether a file exists with content different from content
+func (fw *FileWriter) HasConflict(relativePath string, content string) bool {
+	existing, err := os.ReadFile(filepath.Join(fw.baseDir, relativePath))
+	return err == nil && string(existing) != content
+}
+
 // EnsureDir creates a directory if it doesn't exist
 func (fw *FileWriter) EnsureDir(relativePath string) error {
 	fullPath := filepath.Join(fw.baseDir, relativePath)
@@ -63,7 +127,59 @@ func (fw *FileWriter) DeletePath(relativePath string) error {
 	return os.RemoveAll(fullPath)
 }
 
+// BaseDir returns the directory paths are relative to
+func (fw *FileWriter) BaseDir() string {
+	return fw.baseDir
+}
+
 // GetFullPath returns the full path for a relative path
 func (fw *FileWriter) GetFullPath(relativePath string) string {
 	return filepath.Join(fw.baseDir, relativePath)
 }
+
+// CopyDir recursively copies a directory tree, preserving file modes and symlinks
+func CopyDir(src, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		switch {
+		case info.Mode()&os.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(link, target)
+		case info.IsDir():
+			return os.MkdirAll(target, info.Mode().Perm())
+		default:
+			return copyFile(path, target, info.Mode().Perm())
+		}
+	})
+}
+
+func copyFile(src, dst string, perm os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -1,27 +1,81 @@
 package utils
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"os"
 	"os/exec"
 	"strings"
+	"time"
 )
 
+// Flutter is the part of the Flutter toolchain used by the generators.
+// Cancelling ctx stops the running command and every process it spawned.
+type Flutter interface {
+	Create(ctx context.Context, projectName, org string, force bool) error
+	PubGet(ctx context.Context, offline bool) error
+	PubAdd(ctx context.Context, packages ...string) error
+	PubAddDev(ctx context.Context, packages ...string) error
+	GenL10n(ctx context.Context) error
+	BuildRunnerBuild(ctx context.Context) error
+	DartRun(ctx context.Context, args ...string) error
+}
+
+// FlutterFactory creates a Flutter toolchain running in workingDir and
+// streaming the command output to output. A nil output collects the output
+// into the returned errors instead.
+type FlutterFactory func(workingDir string, output io.Writer) Flutter
+
+// DefaultFlutterFactory creates the real Flutter CLI wrapper
+func DefaultFlutterFactory(workingDir string, output io.Writer) Flutter {
+	return NewFlutterCLI(workingDir, output)
+}
+
+// FlutterBinaryEnv overrides the flutter executable when set
+const FlutterBinaryEnv = "FLINE_FLUTTER"
+
+var flutterBinary string
+
+// SetFlutterBinary overrides the flutter executable (e.g. from --flutter-bin)
+func SetFlutterBinary(path string) {
+	flutterBinary = path
+}
+
+// FlutterBinary returns the flutter executable to run: the --flutter-bin
+// override, then $FLINE_FLUTTER, then flutter from PATH
+func FlutterBinary() string {
+	if flutterBinary != "" {
+		return flutterBinary
+	}
+	if env := os.Getenv(FlutterBinaryEnv); env != "" {
+		return env
+	}
+	return "flutter"
+}
+
 // FlutterCLI wraps Flutter CLI commands
 type FlutterCLI struct {
 	workingDir string
+	output     io.Writer
 }
 
-// NewFlutterCLI creates a new Flutter CLI wrapper
-func NewFlutterCLI(workingDir string) *FlutterCLI {
-	return &FlutterCLI{workingDir: workingDir}
+// NewFlutterCLI creates a new Flutter CLI wrapper. Stdout and stderr of
+// every command are streamed to output when it is not nil.
+func NewFlutterCLI(workingDir string, output io.Writer) *FlutterCLI {
+	return &FlutterCLI{workingDir: workingDir, output: output}
 }
 
 // Create creates a new Flutter project
-func (f *FlutterCLI) Create(projectName, org string, force bool) error {
+func (f *FlutterCLI) Create(ctx context.Context, projectName, org string, force bool) error {
 	args := []string{
 		"create",
 		"--org", org,
 		"--project-name", projectName,
+		// Dependencies are resolved once the real pubspec.yaml is in place
+		"--no-pub",
 	}
 
 	if force {
@@ -30,68 +84,77 @@ func (f *FlutterCLI) Create(projectName, org string, force bool) error {
 
 	args = append(args, projectName)
 
-	cmd := exec.Command("flutter", args...)
-	output, err := cmd.CombinedOutput()
-
-	if err != nil {
-		return fmt.Errorf("flutter create failed: %s\n%s", err, string(output))
-	}
-
-	return nil
+	return f.runCommand(ctx, args...)
 }
 
-// PubGet runs flutter pub get
-func (f *FlutterCLI) PubGet() error {
-	return f.runCommand("pub", "get")
+// PubGet runs flutter pub get, using only the pub cache when offline
+func (f *FlutterCLI) PubGet(ctx context.Context, offline bool) error {
+	if offline {
+		return f.runCommand(ctx, "pub", "get", "--offline")
+	}
+	return f.runCommand(ctx, "pub", "get")
 }
 
 // PubAdd adds a package
-func (f *FlutterCLI) PubAdd(packages ...string) error {
+func (f *FlutterCLI) PubAdd(ctx context.Context, packages ...string) error {
 	args := append([]string{"pub", "add"}, packages...)
-	return f.runCommand(args...)
+	return f.runCommand(ctx, args...)
 }
 
 // PubAddDev adds a dev package
-func (f *FlutterCLI) PubAddDev(packages ...string) error {
+func (f *FlutterCLI) PubAddDev(ctx context.Context, packages ...string) error {
 	args := append([]string{"pub", "add", "--dev"}, packages...)
-	return f.runCommand(args...)
+	return f.runCommand(ctx, args...)
 }
 
 // GenL10n generates localizations
-func (f *FlutterCLI) GenL10n() error {
-	return f.runCommand("gen-l10n")
+func (f *FlutterCLI) GenL10n(ctx context.Context) error {
+	return f.runCommand(ctx, "gen-l10n")
 }
 
 // BuildRunnerBuild runs build_runner
-func (f *FlutterCLI) BuildRunnerBuild() error {
-	return f.runCommand("pub", "run", "build_runner", "build", "--delete-conflicting-outputs")
+func (f *FlutterCLI) BuildRunnerBuild(ctx context.Context) error {
+	return f.runCommand(ctx, "pub", "run", "build_runner", "build", "--delete-conflicting-outputs")
 }
 
 // DartRun runs a dart command
-func (f *FlutterCLI) DartRun(args ...string) error {
+func (f *FlutterCLI) DartRun(ctx context.Context, args ...string) error {
 	fullArgs := append([]string{"pub", "run"}, args...)
-	return f.runCommand(fullArgs...)
+	return f.runCommand(ctx, fullArgs...)
 }
 
-// runCommand executes a flutter command
-func (f *FlutterCLI) runCommand(args ...string) error {
-	cmd := exec.Command("flutter", args...)
+// runCommand executes a flutter command in its own process group, so that
+// cancelling ctx also stops the Dart processes flutter spawns
+func (f *FlutterCLI) runCommand(ctx context.Context, args ...string) error {
+	cmd := exec.CommandContext(ctx, FlutterBinary(), args...)
 	cmd.Dir = f.workingDir
-
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		return fmt.Errorf("flutter %s failed: %s\n%s",
-			strings.Join(args, " "), err, string(output))
+	setProcessGroup(cmd)
+	// Don't wait forever for grandchildren holding the output pipes
+	cmd.WaitDelay = 5 * time.Second
+
+	var collected bytes.Buffer
+	if f.output != nil {
+		cmd.Stdout = f.output
+		cmd.Stderr = f.output
+	} else {
+		cmd.Stdout = &collected
+		cmd.Stderr = &collected
 	}
 
-	return nil
-}
+	err := cmd.Run()
+	if err == nil {
+		return nil
+	}
 
-// CheckFlutterInstalled checks if Flutter is installed
-func CheckFlutterInstalled() error {
-	cmd := exec.Command("flutter", "--version")
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("flutter is not installed or not in PATH")
+	command := "flutter " + strings.Join(args, " ")
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		if errors.Is(ctxErr, context.DeadlineExceeded) {
+			return fmt.Errorf("%s timed out: %w", command, ctxErr)
+		}
+		return fmt.Errorf("%s was cancelled: %w", command, ctxErr)
+	}
+	if f.output != nil {
+		return fmt.Errorf("%s failed: %s", command, err)
 	}
-	return nil
+	return fmt.Errorf("%s failed: %s\n%s", command, err, collected.String())
 }
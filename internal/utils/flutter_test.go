@@ -0,0 +1,94 @@
+//go:build linux
+
+package utils
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeFlutterScript writes a flutter executable that prints its arguments,
+// then starts a child that records its pid and sleeps
+func fakeFlutterScript(t *testing.T) (string, string) {
+	t.Helper()
+
+	dir := t.TempDir()
+	pidFile := filepath.Join(dir, "child.pid")
+	script := filepath.Join(dir, "flutter")
+	content := "#!/bin/sh\necho \"running $*\"\nsleep 30 &\necho $! > " + pidFile + "\nwait\n"
+	if err := os.WriteFile(script, []byte(content), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	SetFlutterBinary(script)
+	t.Cleanup(func() { SetFlutterBinary("") })
+
+	return dir, pidFile
+}
+
+func TestFlutterCLIStreamsOutputAndTimesOut(t *testing.T) {
+	dir, pidFile := fakeFlutterScript(t)
+
+	var output bytes.Buffer
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := NewFlutterCLI(dir, &output).PubGet(ctx, true)
+	if err == nil || !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("PubGet() error = %v, want deadline exceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("PubGet() returned after %s, want prompt return", elapsed)
+	}
+	if !strings.Contains(output.String(), "running pub get --offline") {
+		t.Errorf("output = %q, want streamed command output", output.String())
+	}
+
+	assertProcessGone(t, pidFile)
+}
+
+func TestFlutterCLICancelKillsProcessGroup(t *testing.T) {
+	dir, pidFile := fakeFlutterScript(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(300 * time.Millisecond)
+		cancel()
+	}()
+
+	err := NewFlutterCLI(dir, nil).GenL10n(ctx)
+	if err == nil || !strings.Contains(err.Error(), "flutter gen-l10n was cancelled") {
+		t.Fatalf("GenL10n() error = %v, want cancellation", err)
+	}
+
+	assertProcessGone(t, pidFile)
+}
+
+// assertProcessGone checks that the child recorded in pidFile was killed
+func assertProcessGone(t *testing.T, pidFile string) {
+	t.Helper()
+
+	content, err := os.ReadFile(pidFile)
+	if err != nil {
+		t.Fatalf("child pid not recorded: %v", err)
+	}
+	pid := strings.TrimSpace(string(content))
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		status, err := os.ReadFile(filepath.Join("/proc", pid, "stat"))
+		// Gone, or a zombie waiting for its reaper
+		if err != nil || strings.Contains(string(status), ") Z ") {
+			return
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	t.Errorf("child process %s still running", pid)
+}
@@ -97,6 +97,24 @@ func ValidateOrganization(org string) error {
 	return nil
 }
 
+// ValidateSupabaseURL checks a Supabase project URL. An empty URL is valid:
+// it is filled in later in the env files.
+func ValidateSupabaseURL(url string) error {
+	if url == "" {
+		return nil
+	}
+
+	if !strings.HasPrefix(url, "https://") && !strings.HasPrefix(url, "http://") {
+		return &ValidationError{Field: "supabase-url", Message: "Supabase URL must start with https:// (e.g. https://abcd.supabase.co)"}
+	}
+
+	if strings.ContainsAny(url, " \n") {
+		return &ValidationError{Field: "supabase-url", Message: "Supabase URL cannot contain spaces"}
+	}
+
+	return nil
+}
+
 // ValidationError represents a validation error
 type ValidationError struct {
 	Field   string
@@ -0,0 +1,15 @@
+//go:build windows
+
+package utils
+
+import (
+	"os/exec"
+	"strconv"
+)
+
+// setProcessGroup makes context cancellation kill cmd and its children
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.Cancel = func() error {
+		return exec.Command("taskkill", "/T", "/F", "/PID", strconv.Itoa(cmd.Process.Pid)).Run()
+	}
+}
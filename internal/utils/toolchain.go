@@ -0,0 +1,128 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// Tool is an external program a command may depend on
+type Tool string
+
+const (
+	ToolFlutter Tool = "flutter"
+	ToolDart    Tool = "dart"
+)
+
+// Requirement is a tool a command needs, with its minimum version
+type Requirement struct {
+	Tool       Tool
+	MinVersion Version
+}
+
+func (r Requirement) String() string {
+	return fmt.Sprintf("%s >= %s", r.Tool, r.MinVersion)
+}
+
+var (
+	// FlutterRequirement is the oldest Flutter able to build generated projects
+	FlutterRequirement = Requirement{Tool: ToolFlutter, MinVersion: MustParseVersion("3.32.0")}
+	// DartRequirement matches the sdk constraint of the generated pubspec.yaml
+	DartRequirement = Requirement{Tool: ToolDart, MinVersion: MustParseVersion("3.8.0")}
+)
+
+// Toolchain holds the versions reported by the installed Flutter SDK
+type Toolchain struct {
+	Flutter Version
+	Dart    Version
+}
+
+// Version returns the installed version of tool
+func (t *Toolchain) Version(tool Tool) Version {
+	if tool == ToolDart {
+		return t.Dart
+	}
+	return t.Flutter
+}
+
+// DetectToolchain runs "flutter --version --machine" and parses the Flutter
+// and bundled Dart versions
+func DetectToolchain() (*Toolchain, error) {
+	output, err := exec.Command(FlutterBinary(), "--version", "--machine").Output()
+	if err != nil {
+		return nil, fmt.Errorf("%s not found or not runnable: %w", FlutterBinary(), err)
+	}
+	return parseToolchain(output)
+}
+
+func parseToolchain(output []byte) (*Toolchain, error) {
+	// Flutter may print upgrade banners before the JSON document
+	start := strings.Index(string(output), "{")
+	if start < 0 {
+		return nil, fmt.Errorf("unexpected flutter --version output")
+	}
+
+	var info struct {
+		FrameworkVersion string `json:"frameworkVersion"`
+		DartSdkVersion   string `json:"dartSdkVersion"`
+	}
+	if err := json.NewDecoder(strings.NewReader(string(output[start:]))).Decode(&info); err != nil {
+		return nil, fmt.Errorf("failed to parse flutter --version output: %w", err)
+	}
+
+	flutter, err := ParseVersion(info.FrameworkVersion)
+	if err != nil {
+		return nil, fmt.Errorf("unknown Flutter version: %w", err)
+	}
+	dart, err := ParseVersion(info.DartSdkVersion)
+	if err != nil {
+		return nil, fmt.Errorf("unknown Dart version: %w", err)
+	}
+
+	return &Toolchain{Flutter: flutter, Dart: dart}, nil
+}
+
+// ToolchainError lists the requirements the installed toolchain does not meet
+type ToolchainError struct {
+	Problems []string
+}
+
+func (e *ToolchainError) Error() string {
+	return strings.Join(e.Problems, "; ")
+}
+
+// CheckRequirements verifies that every requirement is installed and recent
+// enough. Dart is provided by the Flutter SDK, so a missing flutter binary
+// fails every requirement.
+func CheckRequirements(reqs []Requirement) error {
+	if len(reqs) == 0 {
+		return nil
+	}
+
+	toolchain, err := DetectToolchain()
+	if err != nil {
+		problems := []string{}
+		for _, req := range reqs {
+			problems = append(problems, fmt.Sprintf("%s is not installed (required: %s)", req.Tool, req))
+		}
+		return &ToolchainError{Problems: problems}
+	}
+
+	return toolchain.Check(reqs)
+}
+
+// Check verifies the toolchain against reqs
+func (t *Toolchain) Check(reqs []Requirement) error {
+	problems := []string{}
+	for _, req := range reqs {
+		if found := t.Version(req.Tool); found.Compare(req.MinVersion) < 0 {
+			problems = append(problems, fmt.Sprintf("%s %s is too old (required: %s)", req.Tool, found, req))
+		}
+	}
+
+	if len(problems) > 0 {
+		return &ToolchainError{Problems: problems}
+	}
+	return nil
+}
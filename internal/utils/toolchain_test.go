@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseToolchain(t *testing.T) {
+	output := []byte(`Flutter 3.35.0 is available, run "flutter upgrade".
+{
+  "frameworkVersion": "3.32.4",
+  "channel": "stable",
+  "dartSdkVersion": "3.8.1 (build 3.8.1)"
+}`)
+
+	tc, err := parseToolchain(output)
+	if err != nil {
+		t.Fatalf("parseToolchain() error = %v", err)
+	}
+	if tc.Flutter.String() != "3.32.4" || tc.Dart.String() != "3.8.1" {
+		t.Errorf("toolchain = %+v", tc)
+	}
+	if err := tc.Check([]Requirement{FlutterRequirement, DartRequirement}); err != nil {
+		t.Errorf("Check() error = %v", err)
+	}
+}
+
+func TestToolchainCheckReportsOutdatedTools(t *testing.T) {
+	tc := &Toolchain{Flutter: MustParseVersion("3.24.0"), Dart: MustParseVersion("3.5.0")}
+
+	err := tc.Check([]Requirement{FlutterRequirement, DartRequirement})
+	if err == nil {
+		t.Fatal("Check() succeeded, want error")
+	}
+	for _, want := range []string{"flutter 3.24.0 is too old (required: flutter >= 3.32.0)", "dart 3.5.0 is too old (required: dart >= 3.8.0)"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not mention %q", err, want)
+		}
+	}
+}
+
+func TestCheckRequirementsMissingFlutter(t *testing.T) {
+	SetFlutterBinary("/nonexistent/flutter")
+	t.Cleanup(func() { SetFlutterBinary("") })
+
+	err := CheckRequirements([]Requirement{FlutterRequirement})
+	if err == nil || !strings.Contains(err.Error(), "flutter is not installed (required: flutter >= 3.32.0)") {
+		t.Errorf("CheckRequirements() error = %v", err)
+	}
+	if err := CheckRequirements(nil); err != nil {
+		t.Errorf("CheckRequirements(nil) error = %v", err)
+	}
+}